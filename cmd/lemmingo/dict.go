@@ -0,0 +1,497 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+// runDict runs "lemmingo dict <subcommand>" commands
+func runDict(args []string) error {
+	name, args, err := subcommand("dict", args, "lint, fmt, dedupe, diff, merge, paradigms, add, compact, stats")
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "lint":
+		return dictLint(args)
+	case "fmt":
+		return dictFmt(args)
+	case "dedupe":
+		return dictDedupe(args)
+	case "diff":
+		return dictDiff(args)
+	case "merge":
+		return dictMerge(args)
+	case "paradigms":
+		return dictParadigms(args)
+	case "add":
+		return dictAdd(args)
+	case "compact":
+		return dictCompact(args)
+	case "stats":
+		return dictStats(args)
+	}
+
+	return fmt.Errorf("dict: unknown subcommand %q", name)
+}
+
+// dictLint reports dictionary issues, failing if there're any
+func dictLint(args []string) error {
+	var opts dicts.LintOptions
+
+	flags := flag.NewFlagSet("dict lint", flag.ContinueOnError)
+	flags.StringVar(&opts.Tagset, "tagset", "", "tagset `name` to check the tags against (default from the header)")
+	flags.StringVar(&opts.Lang, "lang", "", "language `tag` of the tagset (default from the header)")
+	flags.BoolVar(&opts.LemmaForms, "lemma-forms", false, "report lemmas which never appear as forms of themselves")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lemmingo dict lint [flags] dictionary.lmm...")
+		flags.PrintDefaults()
+	}
+
+	if err := parseDictFlags(flags, args, 1); err != nil {
+		return err
+	}
+
+	issues := 0
+
+	for _, path := range flags.Args() {
+		r, err := openInput(path)
+		if err != nil {
+			return err
+		}
+
+		d, err := dicts.Parse(r, path, false)
+		r.Close()
+
+		if err != nil {
+			return err
+		}
+
+		for _, issue := range dicts.Lint(d, opts) {
+			fmt.Println(issue)
+			issues++
+		}
+	}
+
+	if issues > 0 {
+		return fmt.Errorf("dict lint: %d issues found", issues)
+	}
+
+	return nil
+}
+
+// dictFmt sorts the dictionaries in canonical order (form, tag, lemma)
+func dictFmt(args []string) error {
+	var write bool
+
+	flags := flag.NewFlagSet("dict fmt", flag.ContinueOnError)
+	flags.BoolVar(&write, "w", false, "write the result to the source file instead of stdout")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lemmingo dict fmt [-w] dictionary.lmm...")
+		fmt.Fprintln(flags.Output(), "Comments are dropped, malformed lines fail formatting (see lemmingo dict lint).")
+		flags.PrintDefaults()
+	}
+
+	if err := parseDictFlags(flags, args, 1); err != nil {
+		return err
+	}
+
+	for _, path := range flags.Args() {
+		d, err := readDict(path, true)
+		if err != nil {
+			return err
+		}
+
+		d.Sort()
+
+		if err := writeDict(outputPath(path, write), d); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dictDedupe removes duplicate entries reporting them to stderr
+func dictDedupe(args []string) error {
+	var (
+		conflicts bool
+		write     bool
+		output    string
+	)
+
+	flags := flag.NewFlagSet("dict dedupe", flag.ContinueOnError)
+	flags.BoolVar(&conflicts, "conflicts", false, "also keep a single lemma of the same form/tag (the most frequent or the last one)")
+	flags.BoolVar(&write, "w", false, "write the result to the source file")
+	flags.StringVar(&output, "o", "", "output dictionary `file` (default stdout)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lemmingo dict dedupe [flags] dictionary.lmm")
+		flags.PrintDefaults()
+	}
+
+	if err := parseDictFlags(flags, args, 1); err != nil {
+		return err
+	}
+
+	path := flags.Arg(0)
+
+	d, err := readDict(path, true)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range d.Dedupe(conflicts) {
+		fmt.Fprintf(os.Stderr, "%s:%d: removed %s %s %s\n", path, e.Line, e.Form, e.Lemma, e.Tag)
+	}
+
+	if write {
+		output = path
+	}
+
+	return writeDict(output, d)
+}
+
+// dictDiff prints lemma changes between two dictionaries
+func dictDiff(args []string) error {
+	flags := flag.NewFlagSet("dict diff", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lemmingo dict diff old.lmm new.lmm")
+	}
+
+	if err := parseDictFlags(flags, args, 2); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return fmt.Errorf("dict diff: expected two dictionaries")
+	}
+
+	old, err := readDict(flags.Arg(0), false)
+	if err != nil {
+		return err
+	}
+
+	updated, err := readDict(flags.Arg(1), false)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range dicts.Diff(old, updated) {
+		fmt.Println(change)
+	}
+
+	return nil
+}
+
+// dictMerge merges the dictionaries, the later ones override the earlier ones
+func dictMerge(args []string) error {
+	var output string
+
+	flags := flag.NewFlagSet("dict merge", flag.ContinueOnError)
+	flags.StringVar(&output, "o", "", "output dictionary `file` (default stdout)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lemmingo dict merge [-o file] base.lmm overrides.lmm...")
+		flags.PrintDefaults()
+	}
+
+	if err := parseDictFlags(flags, args, 2); err != nil {
+		return err
+	}
+
+	var ds []*dicts.Dictionary
+
+	for _, path := range flags.Args() {
+		d, err := readDict(path, false)
+		if err != nil {
+			return err
+		}
+
+		ds = append(ds, d)
+	}
+
+	merged, err := dicts.Merge(ds...)
+	if err != nil {
+		return err
+	}
+
+	return writeDict(output, merged)
+}
+
+// dictParadigms reports incomplete and anomalous paradigms, optionally writing suggested entries for the missing cells
+func dictParadigms(args []string) error {
+	var (
+		opts   dicts.ParadigmOptions
+		output string
+	)
+
+	flags := flag.NewFlagSet("dict paradigms", flag.ContinueOnError)
+	flags.StringVar(&opts.Tagset, "tagset", "", "tagset `name` to group the tags by universal class (default from the header)")
+	flags.StringVar(&opts.Lang, "lang", "", "language `tag` of the tagset (default from the header)")
+	flags.Float64Var(&opts.MinShare, "min-share", 0.5, "share of the class lemmas having a tag to expect it in every paradigm")
+	flags.Float64Var(&opts.RareShare, "rare-share", 0.01, "share of the class lemmas below which a tag is anomalous")
+	flags.IntVar(&opts.Candidates, "candidates", 1, "`number` of suggested forms per missing cell")
+	flags.StringVar(&output, "o", "", "write suggested entries for the missing cells to the dictionary `file`")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lemmingo dict paradigms [flags] dictionary.lmm")
+		flags.PrintDefaults()
+	}
+
+	if err := parseDictFlags(flags, args, 1); err != nil {
+		return err
+	}
+
+	d, err := readDict(flags.Arg(0), false)
+	if err != nil {
+		return err
+	}
+
+	report, err := dicts.CheckParadigms(d, opts)
+	if err != nil {
+		return err
+	}
+
+	suggested := &dicts.Dictionary{Header: d.Header}
+	suggested.Header.Format = dicts.FormatV2
+
+	for _, p := range report.Paradigms {
+		fmt.Println(p)
+
+		suggested.Entries = append(suggested.Entries, p.Suggestions...)
+	}
+
+	if output == "" {
+		return nil
+	}
+
+	suggested.Sort()
+
+	return writeDict(output, suggested)
+}
+
+// dictAdd generates the paradigm of a new lemma by its inflection class template
+func dictAdd(args []string) error {
+	var (
+		opts     dicts.ParadigmOptions
+		class    string
+		list     bool
+		appendTo bool
+	)
+
+	flags := flag.NewFlagSet("dict add", flag.ContinueOnError)
+	flags.StringVar(&class, "class", "", "inflection `class` of the lemma, e.g. verb-regular or noun-irregular")
+	flags.BoolVar(&list, "list", false, "list the inflection classes with their tags")
+	flags.BoolVar(&appendTo, "a", false, "append the generated entries to the dictionary file instead of stdout")
+	flags.StringVar(&opts.Tagset, "tagset", "", "tagset `name` to group the tags by universal class (default from the header)")
+	flags.StringVar(&opts.Lang, "lang", "", "language `tag` of the tagset (default from the header)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lemmingo dict add -class class [flags] dictionary.lmm lemma [TAG=form...]")
+		fmt.Fprintln(flags.Output(), "       lemmingo dict add -list [flags] dictionary.lmm")
+		flags.PrintDefaults()
+	}
+
+	if err := parseDictFlags(flags, args, 1); err != nil {
+		return err
+	}
+
+	path := flags.Arg(0)
+
+	d, err := readDict(path, false)
+	if err != nil {
+		return err
+	}
+
+	templates, err := dicts.InferTemplates(d, opts)
+	if err != nil {
+		return err
+	}
+
+	if list {
+		for _, name := range templates.Classes() {
+			tags, _ := templates.Tags(name)
+			fmt.Println(name, strings.Join(tags, " "))
+		}
+
+		return nil
+	}
+
+	if class == "" || flags.NArg() < 2 {
+		flags.Usage()
+		return fmt.Errorf("dict add: inflection class and lemma are required")
+	}
+
+	entries, err := templates.Generate(flags.Arg(1), class, flags.Args()[2:]...)
+	if err != nil {
+		return err
+	}
+
+	w := io.Writer(os.Stdout)
+
+	if appendTo {
+		file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		w = file
+	}
+
+	// tab separated lines are valid in both format versions
+	for _, e := range entries {
+		if _, err := fmt.Fprintf(w, "%s\t%s\t%s\n", e.Form, e.Lemma, e.Tag); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseDictFlags parses the subcommand flags and checks there're at least minArgs dictionaries provided
+func parseDictFlags(flags *flag.FlagSet, args []string, minArgs int) error {
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() < minArgs {
+		flags.Usage()
+		return fmt.Errorf("%s: not enough dictionaries provided", flags.Name())
+	}
+
+	return nil
+}
+
+// outputPath returns the source path to write to in place, or stdout
+func outputPath(path string, inPlace bool) string {
+	if inPlace && path != "-" {
+		return path
+	}
+
+	return ""
+}
+
+// dictCompact keeps only the dictionary entries its suffix rules get wrong, writing the rules to the header
+func dictCompact(args []string) error {
+	var (
+		opts   dicts.CompactOptions
+		output string
+	)
+
+	flags := flag.NewFlagSet("dict compact", flag.ContinueOnError)
+	flags.StringVar(&opts.Tagset, "tagset", "", "tagset `name` to map the tags to Universal Tagset (default from the header)")
+	flags.StringVar(&opts.Lang, "lang", "", "language `tag` of the tagset (default from the header)")
+	flags.IntVar(&opts.MinGain, "min-gain", 2, "`number` of entries a rule should predict better than the shorter ones")
+	flags.IntVar(&opts.MaxContext, "context", 3, "`number` of form characters before the changed ending the rules could be conditioned on")
+	flags.StringVar(&output, "o", "", "output dictionary `file` (default stdout)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lemmingo dict compact [flags] dictionary.lmm")
+		flags.PrintDefaults()
+	}
+
+	if err := parseDictFlags(flags, args, 1); err != nil {
+		return err
+	}
+
+	d, err := readDict(flags.Arg(0), false)
+	if err != nil {
+		return err
+	}
+
+	compact, err := dicts.Compact(d, opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "%s: kept %d of %d entries and %d rules\n", flags.Arg(0), len(compact.Entries), len(d.Entries), len(compact.Rules))
+
+	return writeDict(output, compact)
+}
+
+// dictStats prints the dictionary statistics by tag and universal class as a table or JSON
+func dictStats(args []string) error {
+	var (
+		opts     dicts.StatsOptions
+		jsonMode bool
+	)
+
+	flags := flag.NewFlagSet("dict stats", flag.ContinueOnError)
+	flags.StringVar(&opts.Tagset, "tagset", "", "tagset `name` to group the tags by universal class (default from the header)")
+	flags.StringVar(&opts.Lang, "lang", "", "language `tag` of the tagset (default from the header)")
+	flags.BoolVar(&jsonMode, "json", false, "print the statistics as JSON")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lemmingo dict stats [flags] dictionary.lmm")
+		flags.PrintDefaults()
+	}
+
+	if err := parseDictFlags(flags, args, 1); err != nil {
+		return err
+	}
+
+	d, err := readDict(flags.Arg(0), false)
+	if err != nil {
+		return err
+	}
+
+	stats := d.Stats(opts)
+
+	if jsonMode {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(stats)
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', tabwriter.AlignRight)
+
+	printCounts := func(name string, c *dicts.Counts) {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%.2f\t%d\t%.2f%%\t\n", name, c.Entries, c.Forms, c.Lemmas, c.FormsPerLemma, c.Ambiguous, 100*c.Ambiguity)
+	}
+
+	printHeader := func(title string) {
+		fmt.Fprintf(tw, "%s\tentries\tforms\tlemmas\tforms/lemma\tambiguous\tambiguity\t\n", title)
+	}
+
+	printGroups := func(title string, groups map[string]*dicts.Counts) {
+		names := make([]string, 0, len(groups))
+
+		for name := range groups {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		fmt.Fprintln(tw, "\t\t\t\t\t\t\t")
+		printHeader(title)
+
+		for _, name := range names {
+			printCounts(name, groups[name])
+		}
+	}
+
+	printHeader("dictionary")
+	printCounts(flags.Arg(0), &stats.Counts)
+	printGroups("tag", stats.Tags)
+
+	if stats.Classes != nil {
+		printGroups("class", stats.Classes)
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if len(stats.Unmapped) > 0 {
+		fmt.Println("unmapped tags:", strings.Join(stats.Unmapped, " "))
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+// exporters are the dictionary export formats
+var exporters = map[string]func(w io.Writer, d *dicts.Dictionary, opts dicts.ExportOptions) error{
+	"spacy": dicts.ExportSpaCy,
+	"csv":   dicts.ExportCSV,
+	"tsv":   dicts.ExportTSV,
+	"jsonl": dicts.ExportJSONLines,
+}
+
+// runExport runs "lemmingo export" command
+func runExport(args []string) error {
+	var (
+		opts   dicts.ExportOptions
+		format string
+		output string
+	)
+
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.StringVar(&format, "format", "jsonl", "export `format`: spacy, csv, tsv or jsonl")
+	flags.BoolVar(&opts.Universal, "universal", false, "convert the tags to Universal Tagset")
+	flags.StringVar(&opts.Target, "target", "", "convert the tags to the target tagset `name`: universal or upos")
+	flags.StringVar(&opts.Tagset, "tagset", "", "tagset `name` of the dictionary (default from the header)")
+	flags.StringVar(&opts.Lang, "lang", "", "language `tag` of the dictionary tagset (default from the header)")
+	flags.StringVar(&opts.Tag, "pos", "", "export only the entries with this PoS `tag` (after conversion)")
+	flags.StringVar(&output, "o", "", "output `file` (default stdout)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lemmingo export [flags] dictionary.lmm")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return fmt.Errorf("export: expected a single dictionary file")
+	}
+
+	export, ok := exporters[format]
+	if !ok {
+		return fmt.Errorf("export: unknown format %q", format)
+	}
+
+	d, err := readDict(flags.Arg(0), false)
+	if err != nil {
+		return err
+	}
+
+	return writeOutput(output, func(w io.Writer) error {
+		return export(w, d, opts)
+	})
+}
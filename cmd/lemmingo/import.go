@@ -0,0 +1,258 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+// runImport runs "lemmingo import <format>" commands
+func runImport(args []string) error {
+	format, args, err := subcommand("import", args, "conllu, unimorph, hunspell, wordnet")
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "conllu":
+		return importCoNLLU(args)
+	case "unimorph":
+		return importUniMorph(args)
+	case "hunspell":
+		return importHunspell(args)
+	case "wordnet":
+		return importWordNet(args)
+	}
+
+	return fmt.Errorf("import: unknown format %q", format)
+}
+
+// importCoNLLU imports lemma dictionary from CoNLL-U treebanks
+func importCoNLLU(args []string) error {
+	var (
+		opts   dicts.CoNLLUOptions
+		output string
+	)
+
+	flags := flag.NewFlagSet("import conllu", flag.ContinueOnError)
+	flags.BoolVar(&opts.UPOS, "upos", false, "take PoS from UPOS column instead of XPOS")
+	flags.BoolVar(&opts.Contractions, "contractions", false, "add multiword tokens with \"+\" joined lemmas and tags")
+	flags.IntVar(&opts.MinFreq, "min-freq", 1, "drop form/tag pairs seen less than `n` times")
+	flags.StringVar(&opts.Lang, "lang", "", "language `tag` of the dictionary header")
+	flags.StringVar(&opts.Tagset, "tagset", "", "tagset `name` of the dictionary header")
+	flags.StringVar(&output, "o", "", "output dictionary `file` (default stdout)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lemmingo import conllu [flags] treebank.conllu...")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return fmt.Errorf("import conllu: no treebank files provided")
+	}
+
+	imp := dicts.NewCoNLLUImporter(opts)
+
+	for _, path := range flags.Args() {
+		r, err := openInput(path)
+		if err != nil {
+			return err
+		}
+
+		err = imp.Read(r, path)
+		r.Close()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return writeDict(output, imp.Dictionary())
+}
+
+// importUniMorph imports lemma dictionary from UniMorph paradigm files and reports unmapped features to stderr
+func importUniMorph(args []string) error {
+	var (
+		opts   dicts.UniMorphOptions
+		output string
+	)
+
+	flags := flag.NewFlagSet("import unimorph", flag.ContinueOnError)
+	flags.StringVar(&opts.Target, "target", "universal", "target tagset `name` (universal, penn, freeling)")
+	flags.StringVar(&opts.Lang, "lang", "", "language `tag` of the dictionary")
+	flags.BoolVar(&opts.Notes, "notes", false, "keep UniMorph feature bundles in the note column")
+	flags.StringVar(&output, "o", "", "output dictionary `file` (default stdout)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lemmingo import unimorph [flags] paradigms...")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return fmt.Errorf("import unimorph: no UniMorph files provided")
+	}
+
+	imp := dicts.NewUniMorphImporter(opts)
+
+	for _, path := range flags.Args() {
+		r, err := openInput(path)
+		if err != nil {
+			return err
+		}
+
+		err = imp.Read(r, path)
+		r.Close()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	report := imp.Report()
+	printCounts("unmapped feature bundle", report.Bundles)
+	printCounts("unknown feature", report.Features)
+
+	return writeDict(output, imp.Dictionary())
+}
+
+// importHunspell expands Hunspell dictionaries into lemma dictionary
+func importHunspell(args []string) error {
+	var (
+		opts    dicts.HunspellOptions
+		affPath string
+		tags    string
+		output  string
+	)
+
+	flags := flag.NewFlagSet("import hunspell", flag.ContinueOnError)
+	flags.StringVar(&affPath, "aff", "", "affix rules `file` (default the first .dic file with .aff extension)")
+	flags.BoolVar(&opts.Morph, "morph", false, "take PoS tags from po: and is: morphological fields")
+	flags.StringVar(&tags, "tags", "", "comma separated `po[:is]=TAG` mapping of morphological fields (default Universal Tagset)")
+	flags.StringVar(&opts.DefaultTag, "default-tag", "X", "`tag` of the forms without PoS")
+	flags.StringVar(&opts.Lang, "lang", "", "language `tag` of the dictionary header")
+	flags.StringVar(&opts.Tagset, "tagset", "", "tagset `name` of the dictionary header")
+	flags.StringVar(&output, "o", "", "output dictionary `file` (default stdout)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lemmingo import hunspell [flags] dictionary.dic...")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return fmt.Errorf("import hunspell: no .dic files provided")
+	}
+
+	if tags != "" {
+		opts.Tags = make(map[string]string)
+
+		for _, pair := range strings.Split(tags, ",") {
+			fields := strings.SplitN(pair, "=", 2)
+			if len(fields) != 2 {
+				return fmt.Errorf("import hunspell: invalid tag mapping %q", pair)
+			}
+
+			opts.Tags[strings.TrimSpace(fields[0])] = strings.TrimSpace(fields[1])
+		}
+	}
+
+	if affPath == "" {
+		affPath = strings.TrimSuffix(flags.Arg(0), ".dic") + ".aff"
+	}
+
+	aff, err := openInput(affPath)
+	if err != nil {
+		return err
+	}
+
+	x, err := dicts.NewHunspellExpander(aff, affPath, opts)
+	aff.Close()
+
+	if err != nil {
+		return err
+	}
+
+	for _, path := range flags.Args() {
+		r, err := openInput(path)
+		if err != nil {
+			return err
+		}
+
+		err = x.Read(r, path)
+		r.Close()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return writeDict(output, x.Dictionary())
+}
+
+// importWordNet imports lemma dictionary from WordNet database directory
+func importWordNet(args []string) error {
+	var (
+		opts   dicts.WordNetOptions
+		output string
+	)
+
+	flags := flag.NewFlagSet("import wordnet", flag.ContinueOnError)
+	flags.BoolVar(&opts.Index, "index", false, "also add the lemmas of index files")
+	flags.StringVar(&output, "o", "", "output dictionary `file` (default stdout)")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lemmingo import wordnet [flags] wordnet/dict")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return fmt.Errorf("import wordnet: expected a single WordNet database directory")
+	}
+
+	d, err := dicts.ImportWordNet(os.DirFS(flags.Arg(0)), opts)
+	if err != nil {
+		return err
+	}
+
+	return writeDict(output, d)
+}
+
+// printCounts prints counted items to stderr, the most frequent first
+func printCounts(label string, counts map[string]int) {
+	var items []string
+
+	for item := range counts {
+		items = append(items, item)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if counts[items[i]] != counts[items[j]] {
+			return counts[items[i]] > counts[items[j]]
+		}
+
+		return items[i] < items[j]
+	})
+
+	for _, item := range items {
+		fmt.Fprintf(os.Stderr, "%s: %s (%d)\n", label, item, counts[item])
+	}
+}
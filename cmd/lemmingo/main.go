@@ -0,0 +1,167 @@
+// Command lemmingo is a toolkit for building and curating Lemmingo dictionaries
+//
+// Usage:
+//
+//	lemmingo import conllu [flags] treebank.conllu...
+//	lemmingo import unimorph [flags] paradigms...
+//	lemmingo import hunspell [flags] dictionary.dic...
+//	lemmingo import wordnet [flags] wordnet/dict
+//	lemmingo export [flags] dictionary.lmm
+//	lemmingo dict lint [flags] dictionary.lmm...
+//	lemmingo dict fmt [-w] dictionary.lmm...
+//	lemmingo dict dedupe [flags] dictionary.lmm
+//	lemmingo dict diff old.lmm new.lmm
+//	lemmingo dict merge [-o file] base.lmm overrides.lmm...
+//	lemmingo dict paradigms [flags] dictionary.lmm
+//	lemmingo dict add -class class [flags] dictionary.lmm lemma [TAG=form...]
+//	lemmingo dict compact [flags] dictionary.lmm
+//	lemmingo dict stats [flags] dictionary.lmm
+//	lemmingo tagsets [-register name:lang:file]... [-detect file [-lang language]]
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+const usage = `Usage: lemmingo <command> [arguments]
+
+Commands:
+  import conllu    import lemma dictionary from Universal Dependencies CoNLL-U treebanks
+  import unimorph  import lemma dictionary from UniMorph paradigm files
+  import hunspell  expand Hunspell .dic/.aff files into lemma dictionary
+  import wordnet   import lemma dictionary from WordNet exception lists and index files
+  export           export dictionary to spaCy lookup JSON, CSV, TSV or JSON Lines
+  dict lint        check dictionaries for malformed lines, duplicates, conflicts and unknown tags
+  dict fmt         sort dictionaries in canonical order
+  dict dedupe      remove duplicate (and optionally conflicting) entries
+  dict diff        show lemma changes between two dictionaries
+  dict merge       merge dictionaries, the later ones override the earlier ones
+  dict paradigms   report incomplete paradigms and suggest forms for the missing cells
+  dict add         generate the paradigm of a new lemma by its inflection class
+  dict compact     keep only the entries the dictionary suffix rules get wrong
+  dict stats       report lemmas, forms per lemma, ambiguity and unmapped tags by tag and universal class
+  tagsets          list the tagsets available for mapping or detect the dictionary tagset
+
+Run "lemmingo <command> -h" for the command flags.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+
+	switch os.Args[1] {
+	case "import":
+		err = runImport(os.Args[2:])
+	case "export":
+		err = runExport(os.Args[2:])
+	case "dict":
+		err = runDict(os.Args[2:])
+	case "tagsets":
+		err = runTagsets(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		fmt.Print(usage)
+		return
+	default:
+		err = fmt.Errorf("unknown command %q\n\n%s", os.Args[1], usage)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "lemmingo:", err)
+		os.Exit(1)
+	}
+}
+
+// subcommand returns the subcommand name and its arguments, or an error listing the available ones
+func subcommand(command string, args []string, available string) (string, []string, error) {
+	if len(args) == 0 {
+		return "", nil, fmt.Errorf("%s: missing subcommand, available: %s", command, available)
+	}
+
+	return args[0], args[1:], nil
+}
+
+// openInput opens the file (or stdin for "-") transparently decompressing it
+func openInput(path string) (io.ReadCloser, error) {
+	if path == "-" {
+		return dicts.Decompress(os.Stdin)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := dicts.Decompress(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &inputFile{ReadCloser: r, file: file}, nil
+}
+
+// inputFile closes both the decompressor and the underlying file
+type inputFile struct {
+	io.ReadCloser
+	file *os.File
+}
+
+func (f *inputFile) Close() error {
+	defer f.file.Close()
+
+	return f.ReadCloser.Close()
+}
+
+// writeDict writes the dictionary to the file (or stdout for "" and "-")
+func writeDict(path string, d *dicts.Dictionary) error {
+	return writeOutput(path, func(w io.Writer) error {
+		return dicts.Write(w, d)
+	})
+}
+
+// writeOutput runs write with the file (or stdout for "" and "-")
+func writeOutput(path string, write func(w io.Writer) error) error {
+	if path == "" || path == "-" {
+		return write(os.Stdout)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := write(file); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// readDict parses the dictionary file (or stdin for "-"), in lenient mode skipped lines are reported to stderr
+func readDict(path string, strict bool) (*dicts.Dictionary, error) {
+	r, err := openInput(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	d, err := dicts.Parse(r, path, strict)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, warning := range d.Warnings {
+		fmt.Fprintln(os.Stderr, "warning:", warning)
+	}
+
+	return d, nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/smileart/lemmingo/tagset"
+)
+
+// runTagsets runs "lemmingo tagsets" command listing the tagsets available for mapping
+func runTagsets(args []string) error {
+	var (
+		mappings []string
+		detect   string
+		lang     string
+	)
+
+	flags := flag.NewFlagSet("tagsets", flag.ContinueOnError)
+	flags.Func("register", "register the mapping `name:lang:file` (TSV or JSON) before listing, e.g. to validate it", func(value string) error {
+		mappings = append(mappings, value)
+		return nil
+	})
+	flags.StringVar(&detect, "detect", "", "detect the tagset of the dictionary `file` (\"-\" for stdin) instead of listing the tagsets")
+	flags.StringVar(&lang, "lang", "", "consider only the tagsets of the `language` (BCP 47) when detecting")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: lemmingo tagsets [-register name:lang:file]... [-detect file [-lang language]]")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	for _, mapping := range mappings {
+		parts := strings.SplitN(mapping, ":", 3)
+		if len(parts) != 3 {
+			return fmt.Errorf("tagsets: expected name:lang:file mapping, got %q", mapping)
+		}
+
+		if err := tagset.RegisterFile(parts[0], parts[1], parts[2]); err != nil {
+			return err
+		}
+	}
+
+	if detect != "" {
+		return detectTagset(detect, lang)
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "name\tlang\ttags\tsource")
+
+	for _, info := range tagset.List() {
+		source := "registered"
+		if info.Builtin {
+			source = "built-in"
+		}
+
+		tags := fmt.Sprint(info.Tags)
+		if info.Positional {
+			tags = "positional"
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", info.Name, info.Lang, tags, source)
+	}
+
+	return tw.Flush()
+}
+
+// detectTagset scores the tags of the dictionary against the tagsets and prints the matches from the best one
+func detectTagset(path string, lang string) error {
+	d, err := readDict(path, false)
+	if err != nil {
+		return err
+	}
+
+	tags := make([]string, 0, len(d.Entries))
+
+	for _, de := range d.Entries {
+		tags = append(tags, de.Tag)
+	}
+
+	detection := tagset.Detect(tags, lang)
+
+	if detection.Best.Name == "" {
+		return fmt.Errorf("tagsets: none of the tagsets knows the tags of %s", path)
+	}
+
+	fmt.Printf("best: %s %s (confidence %.1f%%)\n\n", detection.Best.Name, detection.Best.Lang, detection.Confidence*100)
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "name\tlang\tcoverage\tunknown")
+
+	for _, m := range detection.Matches {
+		fmt.Fprintf(tw, "%s\t%s\t%.1f%%\t%d\n", m.Name, m.Lang, m.Coverage*100, len(m.Unknown))
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,276 @@
+package dicts
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Rule is a lemmatisation rule of the compact dictionary: the forms with the tag ending with Suffix get their lemma
+// by replacing it with Lemma, e.g. {"VBD", "ied", "y"} for "carried" (declared as "#: rule VBD ied y" header directive)
+type Rule struct {
+	Tag    string
+	Suffix string // form ending (empty for the default rule of the tag)
+	Lemma  string // lemma ending replacing the form one
+}
+
+// CompactOptions configures dictionary compaction
+type CompactOptions struct {
+	Tagset     string // tagset to map the tags to Universal Tagset as the dictionary loader does (taken from the header if empty)
+	Lang       string // language of the tagset (taken from the header if empty)
+	MinGain    int    // number of entries a rule should predict better than the shorter ones to be kept (2 by default)
+	MaxContext int    // number of form characters before the changed ending the rules could be conditioned on (3 by default)
+}
+
+// RuleSet is the index of the compact dictionary rules by tag and form ending
+type RuleSet struct {
+	rules   map[string]map[string]string // tag -> form ending -> lemma ending
+	longest map[string]int               // longest form ending (in runes) by tag
+}
+
+// NewRuleSet indexes the rules by tag and form ending, the later rules override the earlier ones with the same tag and suffix.
+//
+// It returns a pointer to RuleSet struct.
+func NewRuleSet(rules []Rule) *RuleSet {
+	rs := &RuleSet{rules: make(map[string]map[string]string), longest: make(map[string]int)}
+
+	for _, r := range rules {
+		if rs.rules[r.Tag] == nil {
+			rs.rules[r.Tag] = make(map[string]string)
+		}
+
+		rs.rules[r.Tag][r.Suffix] = r.Lemma
+
+		if n := len([]rune(r.Suffix)); n > rs.longest[r.Tag] {
+			rs.longest[r.Tag] = n
+		}
+	}
+
+	return rs
+}
+
+// Lemma applies the rule with the longest suffix matching the form of the tag.
+//
+// It returns the lemma and boolean flag marking if there was a matching rule.
+func (rs *RuleSet) Lemma(form string, tag string) (string, bool) {
+	endings, ok := rs.rules[tag]
+	if !ok {
+		return "", false
+	}
+
+	runes := []rune(form)
+
+	n := rs.longest[tag]
+	if n > len(runes) {
+		n = len(runes)
+	}
+
+	for ; n >= 0; n-- {
+		if lemma, ok := endings[string(runes[len(runes)-n:])]; ok {
+			return string(runes[:len(runes)-n]) + lemma, true
+		}
+	}
+
+	return "", false
+}
+
+// Compact learns suffix rules from the dictionary and drops the entries the rules predict, keeping only the exceptions
+// (irregular forms, multi-word expressions and the entries with the rarer endings) and the rules (see Rule).
+//
+// The dictionary loader combines the rules and exceptions transparently: the lemmas of every form/tag of the dictionary
+// are the same as with the full one (the last entry of the form/tag is kept as on loading), while the memory footprint
+// and load time drop, e.g. for embedded and edge deployments. Forms unknown to the full dictionary could get a rule lemma though.
+//
+// When the dictionary has a tagset (see Tagset option) the tags are mapped to Universal Tagset, as the dictionary loader
+// does by default, so the compact dictionary has Universal Tagset PoS and no tagset in the header
+// (the entries with tags unknown to the tagset are dropped). Load it without tagset.
+//
+// It returns a pointer to Dictionary struct in FormatV2.
+func Compact(d *Dictionary, opts CompactOptions) (*Dictionary, error) {
+	if opts.MinGain == 0 {
+		opts.MinGain = 2
+	}
+
+	if opts.MaxContext == 0 {
+		opts.MaxContext = 3
+	}
+
+	tagsetName, lang := opts.Tagset, opts.Lang
+
+	if tagsetName == "" {
+		tagsetName = d.Header.Tagset
+	}
+
+	if lang == "" {
+		lang = d.Header.Lang
+	}
+
+	compact := &Dictionary{Name: d.Name, Header: d.Header}
+	compact.Header.Format = FormatV2
+
+	var mapPos func(string) (string, bool)
+
+	if tagsetName != "" {
+		if lang == "" {
+			return nil, errors.New("Language of the dictionary is required to map its tags with `" + tagsetName + "` tagset!")
+		}
+
+		var err error
+
+		if mapPos, err = tagsetMapping(tagsetName, lang); err != nil {
+			return nil, err
+		}
+
+		compact.Header.Tagset = ""
+	}
+
+	var entries []Entry
+
+	index := make(map[formTag]int)
+	pairs := make(map[string][]Entry)
+
+	for _, e := range d.Entries {
+		if mapPos != nil {
+			uniPos, ok := mapPos(e.Tag)
+			if !ok {
+				continue
+			}
+
+			e.Tag = uniPos
+		}
+
+		e.Line = 0
+		key := formTag{form: e.Form, tag: e.Tag}
+
+		if i, ok := index[key]; ok {
+			entries[i] = e
+			continue
+		}
+
+		index[key] = len(entries)
+		entries = append(entries, e)
+	}
+
+	for _, e := range entries {
+		if learnable(e) {
+			pairs[e.Tag] = append(pairs[e.Tag], e)
+		}
+	}
+
+	for tag, tagPairs := range pairs {
+		compact.Rules = append(compact.Rules, learnRules(tag, tagPairs, opts)...)
+	}
+
+	sortRules(compact.Rules)
+
+	rules := NewRuleSet(compact.Rules)
+
+	for _, e := range entries {
+		if lemma, ok := rules.Lemma(e.Form, e.Tag); !ok || lemma != e.Lemma || !learnable(e) {
+			compact.Entries = append(compact.Entries, e)
+		}
+	}
+
+	return compact, nil
+}
+
+// learnable tells if the entry could be predicted by the rules: a single word without "_" (the empty column mark)
+func learnable(e Entry) bool {
+	return e.Form != "" && !strings.ContainsAny(e.Form+e.Lemma, "_") &&
+		strings.IndexFunc(e.Form+e.Lemma, unicode.IsSpace) < 0
+}
+
+// learnRules counts the form to lemma ending replacements of every form ending (up to MaxContext characters before
+// the changed one), and keeps the ones predicting at least MinGain more entries than the longest shorter kept ending
+func learnRules(tag string, pairs []Entry, opts CompactOptions) []Rule {
+	votes := make(map[string]map[string]int)
+
+	for _, e := range pairs {
+		f, l := []rune(e.Form), []rune(e.Lemma)
+		i := 0
+
+		for i < len(f) && i < len(l) && f[i] == l[i] {
+			i++
+		}
+
+		for k := 0; k <= opts.MaxContext && k <= i; k++ {
+			suffix := string(f[i-k:])
+
+			if votes[suffix] == nil {
+				votes[suffix] = make(map[string]int)
+			}
+
+			votes[suffix][string(l[i-k:])]++
+		}
+	}
+
+	suffixes := make([]string, 0, len(votes))
+
+	for suffix := range votes {
+		suffixes = append(suffixes, suffix)
+	}
+
+	sort.Slice(suffixes, func(i, j int) bool {
+		a, b := len([]rune(suffixes[i])), len([]rune(suffixes[j]))
+		if a != b {
+			return a < b
+		}
+
+		return suffixes[i] < suffixes[j]
+	})
+
+	kept := make(map[string]string)
+
+	var rules []Rule
+
+	for _, suffix := range suffixes {
+		counts := votes[suffix]
+		best := ""
+
+		for lemma, n := range counts {
+			if n > counts[best] || (n == counts[best] && lemma < best) {
+				best = lemma
+			}
+		}
+
+		gain := counts[best]
+		inherited, ok := inheritedRule(kept, suffix)
+
+		if ok {
+			gain -= counts[inherited]
+		}
+
+		if (!ok || inherited != best) && gain >= opts.MinGain {
+			kept[suffix] = best
+			rules = append(rules, Rule{Tag: tag, Suffix: suffix, Lemma: best})
+		}
+	}
+
+	return rules
+}
+
+// inheritedRule returns the lemma ending the longest kept rule for a shorter ending of the suffix replaces the suffix with
+// (e.g. "ied" -> "ey" for "ed" -> "e" rule)
+func inheritedRule(kept map[string]string, suffix string) (string, bool) {
+	runes := []rune(suffix)
+
+	for n := len(runes) - 1; n >= 0; n-- {
+		if lemma, ok := kept[string(runes[len(runes)-n:])]; ok {
+			return string(runes[:len(runes)-n]) + lemma, true
+		}
+	}
+
+	return "", false
+}
+
+// sortRules sorts the rules by tag and suffix
+func sortRules(rules []Rule) {
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].Tag != rules[j].Tag {
+			return rules[i].Tag < rules[j].Tag
+		}
+
+		return rules[i].Suffix < rules[j].Suffix
+	})
+}
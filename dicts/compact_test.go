@@ -0,0 +1,75 @@
+package dicts_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+func TestCompact(t *testing.T) {
+	data := "cats cat NNS\ndogs dog NNS\nbirds bird NNS\nmice mouse NNS\n" +
+		"cities city NNS\nparties party NNS\nbodies body NNS\n" +
+		"ran run VBD\nwalked walk VBD\njumped jump VBD\n"
+
+	d, err := dicts.Parse(strings.NewReader(data), "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	compact, err := dicts.Compact(d, dicts.CompactOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertEntries(t, compact.Entries, []dicts.Entry{
+		{Form: "mice", Lemma: "mouse", Tag: "NNS"},
+		{Form: "ran", Lemma: "run", Tag: "VBD"},
+	})
+
+	var buf bytes.Buffer
+
+	if err := dicts.Write(&buf, compact); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "#lmm 2\n#: rule NNS ies y\n#: rule NNS s _\n#: rule VBD ed _\nmice\tmouse\tNNS\nran\trun\tVBD\n"
+
+	if buf.String() != expected {
+		t.Errorf("We've got:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+
+	parsed, err := dicts.Parse(&buf, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rules := dicts.NewRuleSet(parsed.Rules)
+
+	for _, e := range d.Entries {
+		if lemma, ok := rules.Lemma(e.Form, e.Tag); ok && lemma != e.Lemma && e.Form != "mice" && e.Form != "ran" {
+			t.Errorf("For the word '%s' we've got: '%s' lemma, expected: '%s'", e.Form, lemma, e.Lemma)
+		}
+	}
+
+	if lemma, ok := rules.Lemma("ponies", "NNS"); !ok || lemma != "pony" {
+		t.Errorf("For the word 'ponies' we've got: '%s' lemma, expected: 'pony'", lemma)
+	}
+
+	if _, ok := rules.Lemma("ponies", "NN"); ok {
+		t.Errorf("The rules were not supposed to match the tag without rules!")
+	}
+
+	if _, err := dicts.Parse(strings.NewReader("#lmm 2\n#: rule NNS s\n"), "", true); err == nil {
+		t.Errorf("The method was supposed to return an error for malformed rule directive!")
+	}
+}
+
+func TestCompactUnknownTagset(t *testing.T) {
+	d := &dicts.Dictionary{Entries: []dicts.Entry{{Form: "cats", Lemma: "cat", Tag: "NNS"}}}
+
+	if _, err := dicts.Compact(d, dicts.CompactOptions{Tagset: "foo", Lang: "en"}); err == nil {
+		t.Errorf("The method was supposed to return an error for the unknown tagset!")
+	}
+}
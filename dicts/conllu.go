@@ -0,0 +1,180 @@
+package dicts
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// CoNLLUOptions configures Universal Dependencies CoNLL-U treebanks import
+type CoNLLUOptions struct {
+	UPOS         bool   // take PoS from UPOS column instead of XPOS (XPOS falls back to UPOS when it's empty)
+	Contractions bool   // also add multiword tokens (e.g. "don't") with "+" joined lemmas and tags of their words ("do+not VBP+RB")
+	MinFreq      int    // drop form/tag pairs seen less than MinFreq times
+	Lang         string // language of the dictionary header
+	Tagset       string // tagset name of the dictionary header (e.g. "penn" for English XPOS)
+}
+
+// CoNLLUImporter accumulates FORM/LEMMA/PoS frequencies from one or more CoNLL-U files
+//
+// REF: https://universaldependencies.org/format.html
+type CoNLLUImporter struct {
+	opts  CoNLLUOptions
+	freqs *frequencies
+}
+
+// conlluWord is a syntactic word of the CoNLL-U sentence
+type conlluWord struct {
+	lemma string
+	tag   string
+}
+
+// conlluRange is a multiword token spanning several syntactic words (e.g. "1-2 don't")
+type conlluRange struct {
+	form  string
+	first int
+	last  int
+}
+
+// NewCoNLLUImporter creates a new CoNLL-U importer with the options provided.
+func NewCoNLLUImporter(opts CoNLLUOptions) *CoNLLUImporter {
+	return &CoNLLUImporter{opts: opts, freqs: newFrequencies()}
+}
+
+// ImportCoNLLU reads a single CoNLL-U file from r and returns the resulting dictionary (see CoNLLUImporter).
+func ImportCoNLLU(r io.Reader, name string, opts CoNLLUOptions) (*Dictionary, error) {
+	imp := NewCoNLLUImporter(opts)
+
+	if err := imp.Read(r, name); err != nil {
+		return nil, err
+	}
+
+	return imp.Dictionary(), nil
+}
+
+// Read counts the words of the CoNLL-U data read from r, name is used in "file:line" errors.
+//
+// Comments, empty nodes (e.g. "8.1") and words without lemma or PoS ("_") are skipped,
+// multiword token ranges (e.g. "1-2") are skipped as well unless Contractions option is on.
+func (imp *CoNLLUImporter) Read(r io.Reader, name string) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	words := make(map[int]conlluWord)
+	var ranges []conlluRange
+
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		if line == "" {
+			imp.addContractions(words, ranges)
+			words, ranges = make(map[int]conlluWord), nil
+
+			continue
+		}
+
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		cols := strings.Split(line, "\t")
+
+		if len(cols) != 10 {
+			return &ParseError{File: name, Line: lineNum, Msg: fmt.Sprintf("expected 10 CoNLL-U columns, got %d", len(cols))}
+		}
+
+		id, form, lemma := cols[0], cols[1], cols[2]
+
+		switch {
+		case strings.Contains(id, "."):
+			// empty node of enhanced dependencies
+			continue
+		case strings.Contains(id, "-"):
+			first, last, ok := parseRange(id)
+			if !ok {
+				return &ParseError{File: name, Line: lineNum, Msg: fmt.Sprintf("invalid multiword token ID %q", id)}
+			}
+
+			ranges = append(ranges, conlluRange{form: strings.ToLower(form), first: first, last: last})
+
+			continue
+		}
+
+		wordID, err := strconv.Atoi(id)
+		if err != nil {
+			return &ParseError{File: name, Line: lineNum, Msg: fmt.Sprintf("invalid word ID %q", id)}
+		}
+
+		tag := cols[3]
+		if !imp.opts.UPOS && cols[4] != emptyColumn {
+			tag = cols[4]
+		}
+
+		if lemma == emptyColumn || tag == emptyColumn || form == "" {
+			continue
+		}
+
+		words[wordID] = conlluWord{lemma: lemma, tag: tag}
+		imp.freqs.add(strings.ToLower(form), lemma, tag, 1)
+	}
+
+	imp.addContractions(words, ranges)
+
+	return scanner.Err()
+}
+
+// Dictionary resolves the counted lemmas by frequency and returns v2 dictionary with frequency column.
+func (imp *CoNLLUImporter) Dictionary() *Dictionary {
+	return &Dictionary{
+		Name:    "conllu",
+		Header:  Header{Format: FormatV2, Lang: imp.opts.Lang, Tagset: imp.opts.Tagset},
+		Entries: imp.freqs.entries(imp.opts.MinFreq),
+	}
+}
+
+// addContractions counts multiword tokens of the sentence with "+" joined lemmas and tags of their words
+func (imp *CoNLLUImporter) addContractions(words map[int]conlluWord, ranges []conlluRange) {
+	if !imp.opts.Contractions {
+		return
+	}
+
+	for _, rng := range ranges {
+		var lemmas, tags []string
+
+		for id := rng.first; id <= rng.last; id++ {
+			word, ok := words[id]
+			if !ok {
+				lemmas = nil
+				break
+			}
+
+			lemmas = append(lemmas, word.lemma)
+			tags = append(tags, word.tag)
+		}
+
+		if len(lemmas) == 0 {
+			continue
+		}
+
+		imp.freqs.add(rng.form, strings.Join(lemmas, "+"), strings.Join(tags, "+"), 1)
+	}
+}
+
+// parseRange parses multiword token ID, e.g. "1-2"
+func parseRange(id string) (int, int, bool) {
+	bounds := strings.SplitN(id, "-", 2)
+
+	first, err := strconv.Atoi(bounds[0])
+	if err != nil {
+		return 0, 0, false
+	}
+
+	last, err := strconv.Atoi(bounds[1])
+	if err != nil || last < first {
+		return 0, 0, false
+	}
+
+	return first, last, true
+}
@@ -0,0 +1,111 @@
+package dicts_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+const sampleCoNLLU = `# sent_id = 1
+# text = I don't saw logs.
+1	I	I	PRON	PRP	Case=Nom|Number=Sing|Person=1|PronType=Prs	4	nsubj	_	_
+2-3	don't	_	_	_	_	_	_	_	_
+2	do	do	AUX	VBP	Mood=Ind|Tense=Pres|VerbForm=Fin	4	aux	_	_
+3	n't	not	PART	RB	_	4	advmod	_	_
+4	saw	saw	VERB	VB	VerbForm=Inf	0	root	_	_
+5	logs	log	NOUN	NNS	Number=Plur	4	obj	_	_
+5.1	cut	cut	VERB	VBD	_	_	_	4:conj	_
+6	.	.	PUNCT	.	_	4	punct	_	_
+
+# sent_id = 2
+1	I	I	PRON	PRP	_	2	nsubj	_	_
+2	saw	see	VERB	VBD	Mood=Ind|Tense=Past|VerbForm=Fin	0	root	_	_
+3	Saw	see	VERB	VBD	_	2	conj	_	_
+4	logs	_	NOUN	NNS	_	2	obj	_	_
+5	saws	saw	NOUN	_	_	2	obj	_	_
+
+`
+
+func TestImportCoNLLU(t *testing.T) {
+	d, err := dicts.ImportCoNLLU(strings.NewReader(sampleCoNLLU), "sample.conllu", dicts.CoNLLUOptions{Lang: "en", Tagset: "penn"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []dicts.Entry{
+		{Form: ".", Lemma: ".", Tag: ".", Freq: 1},
+		{Form: "do", Lemma: "do", Tag: "VBP", Freq: 1},
+		{Form: "i", Lemma: "I", Tag: "PRP", Freq: 2},
+		{Form: "logs", Lemma: "log", Tag: "NNS", Freq: 1},
+		{Form: "n't", Lemma: "not", Tag: "RB", Freq: 1},
+		{Form: "saw", Lemma: "saw", Tag: "VB", Freq: 1},
+		{Form: "saw", Lemma: "see", Tag: "VBD", Freq: 2},
+		{Form: "saws", Lemma: "saw", Tag: "NOUN", Freq: 1},
+	}
+
+	assertEntries(t, d.Entries, expected)
+
+	if d.Header != (dicts.Header{Format: dicts.FormatV2, Lang: "en", Tagset: "penn"}) {
+		t.Errorf("Unexpected header: %+v", d.Header)
+	}
+}
+
+func TestImportCoNLLUOptions(t *testing.T) {
+	imp := dicts.NewCoNLLUImporter(dicts.CoNLLUOptions{UPOS: true, Contractions: true, MinFreq: 2})
+
+	for i := 0; i < 2; i++ {
+		if err := imp.Read(strings.NewReader(sampleCoNLLU), "sample.conllu"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := []dicts.Entry{
+		{Form: ".", Lemma: ".", Tag: "PUNCT", Freq: 2},
+		{Form: "do", Lemma: "do", Tag: "AUX", Freq: 2},
+		{Form: "don't", Lemma: "do+not", Tag: "AUX+PART", Freq: 2},
+		{Form: "i", Lemma: "I", Tag: "PRON", Freq: 4},
+		{Form: "logs", Lemma: "log", Tag: "NOUN", Freq: 2},
+		{Form: "n't", Lemma: "not", Tag: "PART", Freq: 2},
+		{Form: "saw", Lemma: "see", Tag: "VERB", Freq: 6},
+		{Form: "saws", Lemma: "saw", Tag: "NOUN", Freq: 2},
+	}
+
+	assertEntries(t, imp.Dictionary().Entries, expected)
+}
+
+func TestImportCoNLLUMinFreqSplitLemmas(t *testing.T) {
+	data := "1\tleft\tleave\tVERB\tVBN\t_\t0\troot\t_\t_\n\n" +
+		"1\tleft\tleft\tVERB\tVBN\t_\t0\troot\t_\t_\n\n" +
+		"1\tgone\tgo\tVERB\tVBN\t_\t0\troot\t_\t_\n\n"
+
+	// "left" VBN is seen twice with two lemmas, which meets the threshold in total
+	d, err := dicts.ImportCoNLLU(strings.NewReader(data), "split.conllu", dicts.CoNLLUOptions{MinFreq: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertEntries(t, d.Entries, []dicts.Entry{{Form: "left", Lemma: "leave", Tag: "VBN", Freq: 2}})
+}
+
+func TestImportCoNLLUMalformed(t *testing.T) {
+	_, err := dicts.ImportCoNLLU(strings.NewReader("# comment\n1\tI\tI\tPRON\n"), "bad.conllu", dicts.CoNLLUOptions{})
+
+	if err == nil || err.Error() != "bad.conllu:2: expected 10 CoNLL-U columns, got 4" {
+		t.Errorf("Expected malformed line error, got: %v", err)
+	}
+}
+
+func assertEntries(t *testing.T, entries []dicts.Entry, expected []dicts.Entry) {
+	t.Helper()
+
+	if len(entries) != len(expected) {
+		t.Fatalf("We've got entries:\n%+v\nexpected:\n%+v", entries, expected)
+	}
+
+	for i := range expected {
+		if entries[i] != expected[i] {
+			t.Errorf("Entry #%d: we've got: %+v, expected: %+v", i, entries[i], expected[i])
+		}
+	}
+}
@@ -0,0 +1,268 @@
+// Package dicts provides the default Lemmingo dictionaries embedded into the module
+//
+// The dictionaries are stored gzipped (see gen.go) and could be loaded by name, e.g. "embedded:en",
+// or optionally installed into the current user's $HOME/.lemmingo directory for manual editing.
+package dicts
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+//go:generate go run gen.go
+
+// EmbeddedPrefix marks a dictionary path as a name of the embedded dictionary, e.g. "embedded:en"
+const EmbeddedPrefix = "embedded:"
+
+// Version of the embedded dictionaries data, bump it whenever *.lmm files change
+const Version = "0.0.1"
+
+// manifestName is the file which keeps versions and checksums of the installed dictionaries
+const manifestName = ".manifest"
+
+//go:embed *.lmm.gz
+var embedded embed.FS
+
+// Names lists the names of all the embedded dictionaries (e.g. "en").
+func Names() []string {
+	var names []string
+
+	files, _ := fs.Glob(embedded, "*.lmm.gz")
+
+	for _, file := range files {
+		names = append(names, strings.TrimSuffix(file, ".lmm.gz"))
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// IsEmbedded checks if the dictPath refers to an embedded dictionary by the EmbeddedPrefix.
+func IsEmbedded(dictPath string) bool {
+	return strings.HasPrefix(dictPath, EmbeddedPrefix)
+}
+
+// Open opens the embedded dictionary by name, with or without EmbeddedPrefix (e.g. "embedded:en" or "en").
+//
+// It returns a reader with the decompressed dictionary data, which should be closed after the usage.
+func Open(name string) (io.ReadCloser, error) {
+	name = strings.TrimPrefix(name, EmbeddedPrefix)
+
+	dict, err := OpenFS(embedded, name+".lmm.gz")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, errors.New("Embedded dictionary `" + name + "` was not found!")
+	}
+
+	return dict, err
+}
+
+// Checksum calculates the SHA-256 checksum of the decompressed embedded dictionary.
+func Checksum(name string) (string, error) {
+	dict, err := Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer dict.Close()
+
+	return checksum(dict)
+}
+
+// Install writes the embedded dictionaries as plain .lmm files into the dir (or $HOME/.lemmingo if dir is empty).
+//
+// Installation is an explicit opt-in, Lemmingo never writes anything to the disk on its own.
+// Installed dictionaries are versioned and checksummed in the .manifest file, so repeated calls upgrade
+// the files which were not modified after the previous installation and keep the ones edited by the user.
+//
+// It returns the path to the directory with installed dictionaries.
+func Install(dir string) (string, error) {
+	_, dbg := os.LookupEnv("DEBUG")
+
+	if dir == "" {
+		home, err := Home()
+		if err != nil {
+			return "", err
+		}
+
+		dir = home
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	manifestPath := filepath.Join(dir, manifestName)
+
+	manifest, err := readManifest(manifestPath)
+	if err != nil {
+		return "", err
+	}
+
+	for _, name := range Names() {
+		fileName := name + ".lmm"
+		filePath := filepath.Join(dir, fileName)
+
+		embeddedSum, err := Checksum(name)
+		if err != nil {
+			return "", err
+		}
+
+		installedSum, err := fileChecksum(filePath)
+		if err != nil && !os.IsNotExist(err) {
+			return "", err
+		}
+
+		installed, known := manifest[fileName]
+
+		switch {
+		case installedSum == embeddedSum:
+			// already up to date
+		case installedSum != "" && (!known || installed.sum != installedSum):
+			// the file was edited (or put there) by the user, so we keep it as it is
+			if dbg {
+				log.Printf("Lemmingo dictionary %s was modified, skipping the upgrade to %s", filePath, Version)
+			}
+
+			continue
+		default:
+			if dbg {
+				log.Printf("Lemmingo dictionary %s is going to be installed (version %s)", filePath, Version)
+			}
+
+			if err := installDict(name, filePath); err != nil {
+				return "", err
+			}
+		}
+
+		manifest[fileName] = manifestEntry{version: Version, sum: embeddedSum}
+	}
+
+	if err := writeManifest(manifestPath, manifest); err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
+
+// Home returns the path of the current user's $HOME/.lemmingo directory (which might not exist).
+func Home() (string, error) {
+	homePath, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homePath, ".lemmingo"), nil
+}
+
+// manifestEntry describes the installed dictionary file
+type manifestEntry struct {
+	version string
+	sum     string
+}
+
+// installDict writes the decompressed embedded dictionary to the filePath through a temporary file
+func installDict(name string, filePath string) error {
+	dict, err := Open(name)
+	if err != nil {
+		return err
+	}
+	defer dict.Close()
+
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), "."+filepath.Base(filePath)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, dict); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), filePath)
+}
+
+// readManifest reads the manifest in the following format: "file_name<space>version<space>sha256" (missing manifest is empty)
+func readManifest(manifestPath string) (map[string]manifestEntry, error) {
+	manifest := make(map[string]manifestEntry)
+
+	file, err := os.Open(manifestPath)
+	if os.IsNotExist(err) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		if len(fields) != 3 {
+			continue
+		}
+
+		manifest[fields[0]] = manifestEntry{version: fields[1], sum: fields[2]}
+	}
+
+	return manifest, scanner.Err()
+}
+
+// writeManifest writes the manifest sorted by file names
+func writeManifest(manifestPath string, manifest map[string]manifestEntry) error {
+	var names []string
+
+	for name := range manifest {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var b strings.Builder
+
+	for _, name := range names {
+		b.WriteString(name + " " + manifest[name].version + " " + manifest[name].sum + "\n")
+	}
+
+	return os.WriteFile(manifestPath, []byte(b.String()), 0644)
+}
+
+// fileChecksum calculates the SHA-256 checksum of the file
+func fileChecksum(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	return checksum(file)
+}
+
+// checksum calculates the hex encoded SHA-256 checksum of everything read from r
+func checksum(r io.Reader) (string, error) {
+	h := sha256.New()
+
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,134 @@
+package dicts_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+func TestNames(t *testing.T) {
+	names := dicts.Names()
+
+	if len(names) != 1 || names[0] != "en" {
+		t.Errorf("Expected embedded dictionaries to be [en], got: %v", names)
+	}
+}
+
+// Guards the generated *.lmm.gz from getting out of sync with the plain *.lmm (run `go generate ./dicts`)
+func TestOpenMatchesSource(t *testing.T) {
+	for _, name := range []string{"en", "embedded:en"} {
+		dict, err := dicts.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, err := io.ReadAll(dict)
+		dict.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		source, err := os.ReadFile("en.lmm")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(data, source) {
+			t.Errorf("Embedded dictionary `%s` differs from en.lmm, run `go generate ./dicts`", name)
+		}
+	}
+}
+
+func TestOpenMissing(t *testing.T) {
+	if _, err := dicts.Open("embedded:uk"); err == nil {
+		t.Errorf("The method was supposed to return an error on missing embedded dictionary!")
+	}
+}
+
+func TestInstall(t *testing.T) {
+	dir := t.TempDir()
+
+	if _, err := dicts.Install(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	sum, _ := dicts.Checksum("en")
+	if installedSum := fileSum(t, filepath.Join(dir, "en.lmm")); installedSum != sum {
+		t.Errorf("Installed dictionary checksum is %s, expected: %s", installedSum, sum)
+	}
+
+	manifest, err := os.ReadFile(filepath.Join(dir, ".manifest"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(manifest) != "en.lmm "+dicts.Version+" "+sum+"\n" {
+		t.Errorf("Unexpected manifest content: %q", manifest)
+	}
+}
+
+func TestInstallKeepsModified(t *testing.T) {
+	dir := t.TempDir()
+	dictPath := filepath.Join(dir, "en.lmm")
+
+	if _, err := dicts.Install(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(dictPath, []byte("custom custom NN\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := dicts.Install(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	if data, _ := os.ReadFile(dictPath); string(data) != "custom custom NN\n" {
+		t.Errorf("User modified dictionary was overwritten on upgrade!")
+	}
+}
+
+func TestInstallUpgradesUnmodified(t *testing.T) {
+	dir := t.TempDir()
+	dictPath := filepath.Join(dir, "en.lmm")
+	old := "old old NN\n"
+
+	if err := os.WriteFile(dictPath, []byte(old), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	oldSum := fileSum(t, dictPath)
+	manifest := "en.lmm 0.0.0 " + oldSum + "\n"
+
+	if err := os.WriteFile(filepath.Join(dir, ".manifest"), []byte(manifest), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := dicts.Install(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	sum, _ := dicts.Checksum("en")
+	if fileSum(t, dictPath) != sum {
+		t.Errorf("Unmodified dictionary of the previous version wasn't upgraded!")
+	}
+}
+
+func fileSum(t *testing.T, path string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
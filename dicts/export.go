@@ -0,0 +1,216 @@
+package dicts
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/smileart/lemmingo/tagset"
+)
+
+// ExportOptions configures dictionary export
+type ExportOptions struct {
+	Universal bool   // convert the tags to Universal Tagset (unmapped tags are kept as is)
+	Target    string // convert the tags to the target tagset instead: tagset.Universal or tagset.UPOS (word-aware, see tagset.MapTo)
+	Tagset    string // tagset of the dictionary tags (taken from the header if empty)
+	Lang      string // language of the dictionary tagset (taken from the header if empty)
+	Tag       string // export only the entries with this tag (after conversion)
+}
+
+// exportEntry is a JSON Lines record of the dictionary entry
+type exportEntry struct {
+	Form  string `json:"form"`
+	Lemma string `json:"lemma"`
+	Tag   string `json:"tag"`
+	Freq  int    `json:"freq,omitempty"`
+	Feats string `json:"feats,omitempty"`
+	Note  string `json:"note,omitempty"`
+}
+
+// tableHeader is the header row of CSV/TSV export
+var tableHeader = []string{"form", "lemma", "tag", "frequency", "features", "note"}
+
+// ExportSpaCy writes the dictionary as spaCy lookup tables: {"<tag>": {"<form>": "<lemma>"}} with lowercased tags
+// (e.g. "noun" for Universal Tagset, as spaCy lemma exceptions tables), or a single {"<form>": "<lemma>"} table
+// when Tag option is set (as spaCy lemma lookup table).
+//
+// The last lemma is kept for the forms having several ones with the same tag (e.g. several fine tags mapped to one universal),
+// as the dictionary lookups do.
+//
+// REF: https://github.com/explosion/spacy-lookups-data
+func ExportSpaCy(w io.Writer, d *Dictionary, opts ExportOptions) error {
+	entries, err := exportEntries(d, opts)
+	if err != nil {
+		return err
+	}
+
+	tables := make(map[string]map[string]string)
+
+	for _, e := range entries {
+		tag := strings.ToLower(e.Tag)
+
+		if tables[tag] == nil {
+			tables[tag] = make(map[string]string)
+		}
+
+		tables[tag][e.Form] = e.Lemma
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	if opts.Tag != "" {
+		table := tables[strings.ToLower(opts.Tag)]
+		if table == nil {
+			table = make(map[string]string)
+		}
+
+		return enc.Encode(table)
+	}
+
+	return enc.Encode(tables)
+}
+
+// ExportCSV writes the dictionary as comma separated values with "form,lemma,tag,frequency,features,note" header.
+func ExportCSV(w io.Writer, d *Dictionary, opts ExportOptions) error {
+	return exportTable(w, d, ',', opts)
+}
+
+// ExportTSV writes the dictionary as tab separated values with "form lemma tag frequency features note" header.
+func ExportTSV(w io.Writer, d *Dictionary, opts ExportOptions) error {
+	return exportTable(w, d, '\t', opts)
+}
+
+// ExportJSONLines writes the dictionary as JSON object per line: {"form": "mice", "lemma": "mouse", "tag": "NNS"}
+// (with "freq", "feats" and "note" keys when the entry has them), e.g. for search engines bulk indexing.
+func ExportJSONLines(w io.Writer, d *Dictionary, opts ExportOptions) error {
+	entries, err := exportEntries(d, opts)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+
+	for _, e := range entries {
+		record := exportEntry{Form: e.Form, Lemma: e.Lemma, Tag: e.Tag, Freq: e.Freq, Feats: e.Feats, Note: e.Note}
+
+		if err := enc.Encode(record); err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+// exportTable writes the dictionary entries as CSV with the comma separator provided
+func exportTable(w io.Writer, d *Dictionary, comma rune, opts ExportOptions) error {
+	entries, err := exportEntries(d, opts)
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+
+	if err := cw.Write(tableHeader); err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		freq := ""
+		if e.Freq > 0 {
+			freq = strconv.Itoa(e.Freq)
+		}
+
+		if err := cw.Write([]string{e.Form, e.Lemma, e.Tag, freq, e.Feats, e.Note}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// exportEntries converts the tags of the dictionary entries (if requested) and filters them by tag
+func exportEntries(d *Dictionary, opts ExportOptions) ([]Entry, error) {
+	var mapPos func(string, string) (string, bool)
+
+	if opts.Universal || opts.Target != "" {
+		tagsetName, lang := opts.Tagset, opts.Lang
+
+		if tagsetName == "" {
+			tagsetName = d.Header.Tagset
+		}
+
+		if lang == "" {
+			lang = d.Header.Lang
+		}
+
+		if tagsetName == "" || lang == "" {
+			return nil, errors.New("Tagset and language of the dictionary are required for Universal Tagset conversion!")
+		}
+
+		target := opts.Target
+		if target == "" {
+			target = tagset.Universal
+		}
+
+		var err error
+
+		if mapPos, err = targetMapping(target, tagsetName, lang); err != nil {
+			return nil, err
+		}
+	}
+
+	entries := make([]Entry, 0, len(d.Entries))
+
+	for _, e := range d.Entries {
+		if mapPos != nil {
+			if uniPos, ok := mapPos(e.Form, e.Tag); ok {
+				e.Tag = uniPos
+			}
+		}
+
+		if opts.Tag != "" && !strings.EqualFold(e.Tag, opts.Tag) {
+			continue
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+// tagsetMapping returns the Universal Tagset mapping of the tagset (see tagset.MapPos)
+//
+// It returns the error if the tagset isn't registered.
+func tagsetMapping(tagsetName string, lang string) (func(string) (string, bool), error) {
+	if !tagset.Registered(tagsetName, lang) {
+		return nil, errors.New("Tagset mapping for `" + tagsetName + "_" + lang + "` was not found!")
+	}
+
+	return tagset.MapPos(tagsetName, lang), nil
+}
+
+// targetMapping returns the target tagset mapping of the tagset (see tagset.MapTo)
+//
+// It returns the error if the target isn't supported or the tagset isn't registered.
+func targetMapping(target string, tagsetName string, lang string) (func(string, string) (string, bool), error) {
+	if target != tagset.Universal && target != tagset.UPOS {
+		return nil, errors.New("Target tagset `" + target + "` is not supported!")
+	}
+
+	if !tagset.Registered(tagsetName, lang) {
+		return nil, errors.New("Tagset mapping for `" + tagsetName + "_" + lang + "` was not found!")
+	}
+
+	return tagset.MapTo(target, tagsetName, lang), nil
+}
@@ -0,0 +1,145 @@
+package dicts_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/smileart/lemmingo/dicts"
+	"github.com/smileart/lemmingo/tagset"
+)
+
+var exportDict = &dicts.Dictionary{
+	Header: dicts.Header{Format: dicts.FormatV2, Lang: "en", Tagset: "penn"},
+	Entries: []dicts.Entry{
+		{Form: "mice", Lemma: "mouse", Tag: "NNS", Freq: 12},
+		{Form: "ran", Lemma: "run", Tag: "VBD", Feats: "Tense=Past", Note: "irregular, \"strong\""},
+		{Form: "ran out", Lemma: "run out", Tag: "VBD"},
+		{Form: "saw", Lemma: "see", Tag: "VBD"},
+		{Form: "saw", Lemma: "saw", Tag: "NN"},
+	},
+}
+
+type ExportTestCase struct {
+	name     string
+	export   func(w *bytes.Buffer) error
+	expected string
+}
+
+func TestExport(t *testing.T) {
+	testCases := []ExportTestCase{
+		{
+			name: "spaCy",
+			export: func(w *bytes.Buffer) error {
+				return dicts.ExportSpaCy(w, exportDict, dicts.ExportOptions{Universal: true})
+			},
+			expected: `{
+  "noun": {
+    "mice": "mouse",
+    "saw": "saw"
+  },
+  "verb": {
+    "ran": "run",
+    "ran out": "run out",
+    "saw": "see"
+  }
+}
+`,
+		},
+		{
+			name:     "spaCy table",
+			export:   func(w *bytes.Buffer) error { return dicts.ExportSpaCy(w, exportDict, dicts.ExportOptions{Tag: "NNS"}) },
+			expected: "{\n  \"mice\": \"mouse\"\n}\n",
+		},
+		{
+			name:   "CSV",
+			export: func(w *bytes.Buffer) error { return dicts.ExportCSV(w, exportDict, dicts.ExportOptions{Tag: "VBD"}) },
+			expected: "form,lemma,tag,frequency,features,note\n" +
+				"ran,run,VBD,,Tense=Past,\"irregular, \"\"strong\"\"\"\n" +
+				"ran out,run out,VBD,,,\n" +
+				"saw,see,VBD,,,\n",
+		},
+		{
+			name: "TSV",
+			export: func(w *bytes.Buffer) error {
+				return dicts.ExportTSV(w, exportDict, dicts.ExportOptions{Tag: "noun", Universal: true})
+			},
+			expected: "form\tlemma\ttag\tfrequency\tfeatures\tnote\n" +
+				"mice\tmouse\tNOUN\t12\t\t\n" +
+				"saw\tsaw\tNOUN\t\t\t\n",
+		},
+		{
+			name: "JSON Lines",
+			export: func(w *bytes.Buffer) error {
+				return dicts.ExportJSONLines(w, exportDict, dicts.ExportOptions{Tag: "NNS"})
+			},
+			expected: `{"form":"mice","lemma":"mouse","tag":"NNS","freq":12}
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		var buf bytes.Buffer
+
+		if err := tc.export(&buf); err != nil {
+			t.Fatalf("%s: %s", tc.name, err)
+		}
+
+		if buf.String() != tc.expected {
+			t.Errorf("%s: we've got:\n%s\nexpected:\n%s", tc.name, buf.String(), tc.expected)
+		}
+	}
+}
+
+func TestExportUniversalWithoutTagset(t *testing.T) {
+	d := &dicts.Dictionary{Entries: exportDict.Entries}
+
+	if err := dicts.ExportJSONLines(&bytes.Buffer{}, d, dicts.ExportOptions{Universal: true}); err == nil {
+		t.Errorf("The method was supposed to return an error for the dictionary without tagset!")
+	}
+}
+
+func TestExportUniversalUnknownTagset(t *testing.T) {
+	if err := dicts.ExportSpaCy(&bytes.Buffer{}, exportDict, dicts.ExportOptions{Universal: true, Tagset: "foo"}); err == nil {
+		t.Errorf("The method was supposed to return an error for the unknown tagset!")
+	}
+}
+
+func TestExportSpaCyLastLemma(t *testing.T) {
+	d := &dicts.Dictionary{Entries: []dicts.Entry{{Form: "'s", Lemma: "be", Tag: "VBZ"}, {Form: "'s", Lemma: "have", Tag: "VBZ"}}}
+
+	var b bytes.Buffer
+
+	if err := dicts.ExportSpaCy(&b, d, dicts.ExportOptions{Tag: "VBZ"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if b.String() != "{\n  \"'s\": \"have\"\n}\n" {
+		t.Errorf("We've got: %q", b.String())
+	}
+}
+
+func TestExportUPOS(t *testing.T) {
+	d := &dicts.Dictionary{
+		Header:  exportDict.Header,
+		Entries: []dicts.Entry{{Form: "ran", Lemma: "run", Tag: "VBD"}, {Form: "was", Lemma: "be", Tag: "VBD"}, {Form: "mice", Lemma: "mouse", Tag: "NNS"}},
+	}
+
+	var b bytes.Buffer
+
+	if err := dicts.ExportTSV(&b, d, dicts.ExportOptions{Target: tagset.UPOS}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "form\tlemma\ttag\tfrequency\tfeatures\tnote\n" +
+		"ran\trun\tVERB\t\t\t\n" +
+		"was\tbe\tAUX\t\t\t\n" +
+		"mice\tmouse\tNOUN\t\t\t\n"
+
+	if b.String() != expected {
+		t.Errorf("We've got:\n%s\nexpected:\n%s", b.String(), expected)
+	}
+
+	if err := dicts.ExportTSV(&bytes.Buffer{}, d, dicts.ExportOptions{Target: "foo"}); err == nil {
+		t.Errorf("The method was supposed to return an error for the unknown target tagset!")
+	}
+}
@@ -0,0 +1,169 @@
+package dicts
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Dictionary format versions
+//
+// v1 is three bare columns: "form lemma tag".
+//
+// v2 starts with the "#lmm 2" marker line, followed by optional "#: key value" header directives
+// (lang, tagset, licence, version), "#: rule TAG suffix lemma" rules of compact dictionaries (see Compact) and entries with optional extra columns:
+// "form lemma tag [frequency [features [note]]]", where "_" stands for an empty column,
+// features are UD-style "Key=Value|Key=Value" and the note takes the rest of the line.
+const (
+	FormatV1 = 1
+	FormatV2 = 2
+)
+
+// formatMarker starts the first line of v2+ dictionaries, e.g. "#lmm 2"
+const formatMarker = "#lmm "
+
+// headerPrefix starts header directive lines of v2+ dictionaries, e.g. "#: lang en"
+const headerPrefix = "#:"
+
+// emptyColumn marks an empty optional column
+const emptyColumn = "_"
+
+// Header is the dictionary metadata declared in v2+ dictionaries
+type Header struct {
+	Format  int    // format version: FormatV1 (no header) or FormatV2
+	Lang    string // BCP 47 language tag of the dictionary
+	Tagset  string // source tagset name of the dictionary PoS tags (e.g. "penn", "freeling")
+	Licence string // licence of the dictionary data (e.g. "AGPL-3.0")
+	Version string // version of the dictionary data
+}
+
+// set sets the header field by directive key
+//
+// It returns false for unknown keys.
+func (h *Header) set(key string, value string) bool {
+	switch key {
+	case "lang":
+		h.Lang = value
+	case "tagset":
+		h.Tagset = value
+	case "licence", "license":
+		h.Licence = value
+	case "version":
+		h.Version = value
+	default:
+		return false
+	}
+
+	return true
+}
+
+// Write writes the dictionary d to w in its header format.
+//
+// v1 dictionaries get written as space separated "form lemma tag" lines (tab separated if any column has spaces,
+// e.g. multi-word expressions), v2 ones as tab separated lines after the header (trailing empty optional columns are omitted).
+func Write(w io.Writer, d *Dictionary) error {
+	bw := bufio.NewWriter(w)
+
+	if d.Header.Format >= FormatV2 {
+		writeHeader(bw, d)
+	}
+
+	for _, e := range d.Entries {
+		if d.Header.Format >= FormatV2 {
+			bw.WriteString(strings.Join(columns(e), "\t") + "\n")
+		} else {
+			separator := " "
+			if strings.Contains(e.Form+e.Lemma+e.Tag, " ") {
+				separator = "\t"
+			}
+
+			bw.WriteString(strings.Join([]string{escape(e.Form), escape(e.Lemma), e.Tag}, separator) + "\n")
+		}
+	}
+
+	return bw.Flush()
+}
+
+// writeHeader writes the format marker, all non-empty header directives and the dictionary rules
+func writeHeader(bw *bufio.Writer, d *Dictionary) {
+	h := d.Header
+
+	bw.WriteString(formatMarker + strconv.Itoa(h.Format) + "\n")
+
+	directives := [][2]string{
+		{"lang", h.Lang},
+		{"tagset", h.Tagset},
+		{"licence", h.Licence},
+		{"version", h.Version},
+	}
+
+	for _, directive := range directives {
+		if directive[1] != "" {
+			bw.WriteString(headerPrefix + " " + directive[0] + " " + directive[1] + "\n")
+		}
+	}
+
+	for _, r := range d.Rules {
+		bw.WriteString(headerPrefix + " rule " + r.Tag + " " + orEmpty(r.Suffix) + " " + orEmpty(r.Lemma) + "\n")
+	}
+}
+
+// orEmpty returns the value or "_" for the empty one
+func orEmpty(value string) string {
+	if value == "" {
+		return emptyColumn
+	}
+
+	return value
+}
+
+// column returns the column value or "" for "_"
+func column(value string) string {
+	if value == emptyColumn {
+		return ""
+	}
+
+	return value
+}
+
+// columns returns v2 columns of the entry without trailing empty optional ones
+func columns(e Entry) []string {
+	cols := []string{escape(e.Form), escape(e.Lemma), e.Tag, emptyColumn, emptyColumn, emptyColumn}
+
+	if e.Freq != 0 {
+		cols[3] = strconv.Itoa(e.Freq)
+	}
+
+	if e.Feats != "" {
+		cols[4] = e.Feats
+	}
+
+	if e.Note != "" {
+		cols[5] = e.Note
+	}
+
+	for len(cols) > 3 && cols[len(cols)-1] == emptyColumn {
+		cols = cols[:len(cols)-1]
+	}
+
+	return cols
+}
+
+// escape escapes the leading "#" of the word, so it's not treated as a comment
+func escape(word string) string {
+	if strings.HasPrefix(word, "#") {
+		return `\` + word
+	}
+
+	return word
+}
+
+// unescape turns the escaped leading "\#" of the word into a literal "#"
+func unescape(word string) string {
+	if strings.HasPrefix(word, `\#`) {
+		return word[1:]
+	}
+
+	return word
+}
@@ -0,0 +1,176 @@
+package dicts_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+const v2Dict = `#lmm 2
+# English sample
+#: lang en-GB
+#: tagset penn
+#: licence AGPL-3.0
+#: version 2020.1
+ran	run	VBD	120	Tense=Past|VerbForm=Fin
+runs	run	VBZ	_	_	third person singular
+mice mouse NNS 15 Number=Plur irregular plural noun
+am be VBP
+`
+
+func TestParseV2(t *testing.T) {
+	d, err := dicts.Parse(strings.NewReader(v2Dict), "v2.lmm", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header := dicts.Header{Format: dicts.FormatV2, Lang: "en-GB", Tagset: "penn", Licence: "AGPL-3.0", Version: "2020.1"}
+	if d.Header != header {
+		t.Errorf("We've got header: %+v, expected: %+v", d.Header, header)
+	}
+
+	entries := []dicts.Entry{
+		{Form: "ran", Lemma: "run", Tag: "VBD", Freq: 120, Feats: "Tense=Past|VerbForm=Fin", Line: 7},
+		{Form: "runs", Lemma: "run", Tag: "VBZ", Note: "third person singular", Line: 8},
+		{Form: "mice", Lemma: "mouse", Tag: "NNS", Freq: 15, Feats: "Number=Plur", Note: "irregular plural noun", Line: 9},
+		{Form: "am", Lemma: "be", Tag: "VBP", Line: 10},
+	}
+
+	for i, e := range entries {
+		if i >= len(d.Entries) || d.Entries[i] != e {
+			t.Errorf("Entry #%d: expected: %+v, got: %+v", i, e, d.Entries)
+		}
+	}
+}
+
+func TestParseV1Unchanged(t *testing.T) {
+	d, err := dicts.Parse(strings.NewReader("#: lang en\nam be VBP\n"), "v1.lmm", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Header != (dicts.Header{Format: dicts.FormatV1}) {
+		t.Errorf("v1 dictionary got header: %+v", d.Header)
+	}
+
+	if _, err := dicts.Parse(strings.NewReader("am be VBP 10\n"), "v1.lmm", true); err == nil {
+		t.Errorf("v1 dictionary was supposed to reject extra columns!")
+	}
+}
+
+func TestParseV2Errors(t *testing.T) {
+	cases := map[string]string{
+		"#lmm 3\nam be VBP\n":                      "v2.lmm:1: unsupported format \"#lmm 3\"",
+		"#lmm 2\n#: dialect scouse\n":              "v2.lmm:2: unknown header directive \"dialect\"",
+		"#lmm 2\n#: lang ???\n":                    "v2.lmm:2: invalid language tag \"???\"",
+		"#lmm 2\nam be VBP\n#: lang en\n":          "v2.lmm:3: header directive after dictionary entries in \"#: lang en\"",
+		"#lmm 2\nam be VBP many\n":                 "v2.lmm:2: invalid frequency \"many\" in \"am be VBP many\"",
+		"#lmm 2\nam be VBP 1 Past\n":               "v2.lmm:2: invalid features \"Past\" in \"am be VBP 1 Past\"",
+		"#lmm 2\nam _ VBP\n":                       "v2.lmm:2: empty field #2 in \"am _ VBP\"",
+		"am be VBP\n#lmm 2\n":                      "v2.lmm:2: format marker should be the first line of the dictionary",
+		"#lmm 2\nam\tbe\tVBP\t1\t_\tnote\textra\n": "v2.lmm:2: expected 3 to 6 fields (form, lemma, tag, frequency, features, note), got 7 in \"am\\tbe\\tVBP\\t1\\t_\\tnote\\textra\"",
+	}
+
+	for data, expected := range cases {
+		_, err := dicts.Parse(strings.NewReader(data), "v2.lmm", true)
+
+		if err == nil || err.Error() != expected {
+			t.Errorf("For %q we've got error: %v, expected: %s", data, err, expected)
+		}
+	}
+}
+
+func TestWriteRoundTrip(t *testing.T) {
+	d, err := dicts.Parse(strings.NewReader(v2Dict), "v2.lmm", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+
+	if err := dicts.Write(&b, d); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "#lmm 2\n#: lang en-GB\n#: tagset penn\n#: licence AGPL-3.0\n#: version 2020.1\n" +
+		"ran\trun\tVBD\t120\tTense=Past|VerbForm=Fin\n" +
+		"runs\trun\tVBZ\t_\t_\tthird person singular\n" +
+		"mice\tmouse\tNNS\t15\tNumber=Plur\tirregular plural noun\n" +
+		"am\tbe\tVBP\n"
+
+	if b.String() != expected {
+		t.Errorf("We've got:\n%s\nexpected:\n%s", b.String(), expected)
+	}
+}
+
+func TestWriteV1(t *testing.T) {
+	d := &dicts.Dictionary{Entries: []dicts.Entry{{Form: "#", Lemma: "#", Tag: "#"}, {Form: "am", Lemma: "be", Tag: "VBP", Freq: 3}}}
+
+	var b bytes.Buffer
+
+	if err := dicts.Write(&b, d); err != nil {
+		t.Fatal(err)
+	}
+
+	if b.String() != "\\# \\# #\nam be VBP\n" {
+		t.Errorf("We've got: %q", b.String())
+	}
+}
+
+func TestWriteV1MultiWord(t *testing.T) {
+	d, err := dicts.Parse(strings.NewReader("ran out\trun out\tVBD\nran run VBD\n"), "v1.lmm", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+
+	if err := dicts.Write(&b, d); err != nil {
+		t.Fatal(err)
+	}
+
+	if b.String() != "ran out\trun out\tVBD\nran run VBD\n" {
+		t.Errorf("We've got: %q", b.String())
+	}
+
+	written, err := dicts.Parse(&b, "v1.lmm", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(written.Entries, d.Entries) {
+		t.Errorf("We've got entries: %+v, expected: %+v", written.Entries, d.Entries)
+	}
+}
+
+func TestWriteEscapedRoundTrip(t *testing.T) {
+	entries := []dicts.Entry{{Form: "#", Lemma: "#", Tag: "SYM"}, {Form: "#tags", Lemma: "#tag", Tag: "NNS"}}
+
+	for _, format := range []int{dicts.FormatV1, dicts.FormatV2} {
+		d := &dicts.Dictionary{Header: dicts.Header{Format: format}, Entries: entries}
+
+		var b bytes.Buffer
+
+		if err := dicts.Write(&b, d); err != nil {
+			t.Fatal(err)
+		}
+
+		written, err := dicts.Parse(&b, "escaped.lmm", true)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(written.Entries) != len(entries) {
+			t.Fatalf("Format %d: we've got entries: %+v, expected: %+v", format, written.Entries, entries)
+		}
+
+		for i, e := range written.Entries {
+			if e.Form != entries[i].Form || e.Lemma != entries[i].Lemma || e.Tag != entries[i].Tag {
+				t.Errorf("Format %d: we've got entry: %+v, expected: %+v", format, e, entries[i])
+			}
+		}
+	}
+}
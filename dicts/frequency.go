@@ -0,0 +1,82 @@
+package dicts
+
+import (
+	"sort"
+)
+
+// frequencies counts lemmas for every form/tag pair seen by importers
+type frequencies struct {
+	counts map[formTag]map[string]int
+}
+
+// formTag is an inflected form with its PoS tag
+type formTag struct {
+	form string
+	tag  string
+}
+
+func newFrequencies() *frequencies {
+	return &frequencies{counts: make(map[formTag]map[string]int)}
+}
+
+// add counts the lemma of the form/tag pair n times
+func (f *frequencies) add(form string, lemma string, tag string, n int) {
+	key := formTag{form: form, tag: tag}
+
+	if f.counts[key] == nil {
+		f.counts[key] = make(map[string]int)
+	}
+
+	f.counts[key][lemma] += n
+}
+
+// entries resolves conflicting lemmas of every form/tag pair by frequency (ties are broken alphabetically)
+// and drops the pairs seen less than minFreq times (with any lemma).
+//
+// It returns entries sorted by form and tag with the frequency of the form/tag pair.
+func (f *frequencies) entries(minFreq int) []Entry {
+	var entries []Entry
+
+	for key, lemmas := range f.counts {
+		var (
+			best  string
+			count int
+			total int
+		)
+
+		for lemma, n := range lemmas {
+			total += n
+
+			if n > count || (n == count && lemma < best) {
+				best, count = lemma, n
+			}
+		}
+
+		if total < minFreq {
+			continue
+		}
+
+		entries = append(entries, Entry{Form: key.form, Lemma: best, Tag: key.tag, Freq: total})
+	}
+
+	sortEntries(entries)
+
+	return entries
+}
+
+// sortEntries sorts entries by form, tag and lemma
+func sortEntries(entries []Entry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+
+		if a.Form != b.Form {
+			return a.Form < b.Form
+		}
+
+		if a.Tag != b.Tag {
+			return a.Tag < b.Tag
+		}
+
+		return a.Lemma < b.Lemma
+	})
+}
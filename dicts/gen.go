@@ -0,0 +1,58 @@
+//go:build ignore
+// +build ignore
+
+// gen compresses every *.lmm dictionary in the current directory into *.lmm.gz for embedding
+//
+// Usage: go generate ./dicts
+package main
+
+import (
+	"compress/gzip"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	dicts, err := filepath.Glob("*.lmm")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, dict := range dicts {
+		if err := compress(dict, dict+".gz"); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// compress gzips src into dst deterministically (no name and modification time in the header)
+func compress(src string, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	gz, err := gzip.NewWriterLevel(out, gzip.BestCompression)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(gz, in); err != nil {
+		return err
+	}
+
+	if err := gz.Close(); err != nil {
+		return err
+	}
+
+	return out.Close()
+}
@@ -0,0 +1,669 @@
+package dicts
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/transform"
+)
+
+// HunspellOptions configures Hunspell dictionaries expansion
+type HunspellOptions struct {
+	Morph      bool              // take PoS tags from "po:" fields of the stems/affixes and "is:" fields of the affixes
+	Tags       map[string]string // maps "po:is" and "po" values (e.g. "verb:past", "noun") to tags, Universal Tagset mapping by default
+	DefaultTag string            // tag of the forms without PoS ("X" by default)
+	Lang       string            // language of the dictionary header
+	Tagset     string            // tagset name of the dictionary header (should match Tags)
+}
+
+// HunspellExpander generates every form of the stems read from Hunspell .dic files with the affix rules of .aff file,
+// the stem (or "st:" field of the entry, if any) is used as a lemma of all its forms.
+//
+// Compounding, case conversion and suggestion directives of .aff file are ignored,
+// stems flagged as FORBIDDENWORD or ONLYINCOMPOUND are skipped.
+//
+// REF: https://manpages.ubuntu.com/manpages/focal/man5/hunspell.5.html
+type HunspellExpander struct {
+	opts     HunspellOptions
+	aff      *hunspellAff
+	freqs    *frequencies
+	expanded map[Entry]bool
+}
+
+// hunspellAff holds the affix rules and the directives of .aff file relevant for the expansion
+type hunspellAff struct {
+	encoding       string
+	flagType       string
+	flagAliases    [][]string
+	morphAliases   [][]string
+	aliasHeaders   map[string]bool
+	prefixes       map[string]*hunspellAffix
+	suffixes       map[string]*hunspellAffix
+	needAffix      string
+	forbiddenWord  string
+	onlyInCompound string
+}
+
+// hunspellAffix is a PFX/SFX class of rules sharing the same flag
+type hunspellAffix struct {
+	crossProduct bool
+	rules        []hunspellRule
+}
+
+// hunspellRule is a single PFX/SFX rule, e.g. "SFX D y ied [^aeiou]y is:past"
+type hunspellRule struct {
+	strip     string
+	add       string
+	flags     []string
+	condition []hunspellCondition
+	morph     []string
+}
+
+// hunspellCondition is a single character condition of the rule: ".", "a" or "[^aeiou]"
+type hunspellCondition struct {
+	any    bool
+	negate bool
+	chars  string
+}
+
+// hunspellForm is a generated form with the morphological fields of its stem and affixes
+type hunspellForm struct {
+	word         string
+	flags        []string
+	morph        []string
+	crossProduct bool // the affix of the form could be combined with the affix of the other type
+}
+
+// hunspellUniversal maps common "po:" values to Universal Tagset
+var hunspellUniversal = map[string]string{
+	"noun":   "NOUN",
+	"propn":  "NOUN",
+	"prop":   "NOUN",
+	"verb":   "VERB",
+	"aux":    "VERB",
+	"adj":    "ADJ",
+	"adv":    "ADV",
+	"pron":   "PRON",
+	"det":    "DET",
+	"art":    "DET",
+	"prep":   "ADP",
+	"adp":    "ADP",
+	"num":    "NUM",
+	"conj":   "CONJ",
+	"cconj":  "CONJ",
+	"sconj":  "CONJ",
+	"part":   "PRT",
+	"intj":   "X",
+	"interj": "X",
+}
+
+// NewHunspellExpander parses the affix rules read from aff, name is used in "file:line" errors.
+func NewHunspellExpander(aff io.Reader, name string, opts HunspellOptions) (*HunspellExpander, error) {
+	if opts.Tags == nil {
+		opts.Tags = hunspellUniversal
+	}
+
+	if opts.DefaultTag == "" {
+		opts.DefaultTag = "X"
+	}
+
+	rules, err := parseAff(aff, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HunspellExpander{
+		opts:     opts,
+		aff:      rules,
+		freqs:    newFrequencies(),
+		expanded: make(map[Entry]bool),
+	}, nil
+}
+
+// ImportHunspell expands a single Hunspell dictionary (see HunspellExpander).
+func ImportHunspell(aff io.Reader, affName string, dic io.Reader, dicName string, opts HunspellOptions) (*Dictionary, error) {
+	x, err := NewHunspellExpander(aff, affName, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := x.Read(dic, dicName); err != nil {
+		return nil, err
+	}
+
+	return x.Dictionary(), nil
+}
+
+// Read expands the stems of .dic file read from r (in the encoding set by the .aff file), name is used in "file:line" errors.
+func (x *HunspellExpander) Read(r io.Reader, name string) error {
+	r, err := decodeHunspell(r, x.aff.encoding)
+	if err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(r)
+
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		// the first line is an approximate number of stems
+		if lineNum == 1 && isNumber(strings.TrimSpace(strings.TrimPrefix(line, "\ufeff"))) {
+			continue
+		}
+
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "\t") {
+			continue
+		}
+
+		stem, flags, morph, err := x.aff.parseStem(line)
+		if err != nil {
+			return &ParseError{File: name, Line: lineNum, Msg: err.Error()}
+		}
+
+		x.expand(stem, flags, morph)
+	}
+
+	return scanner.Err()
+}
+
+// Dictionary returns v2 dictionary with the expanded forms, conflicting lemmas of the same form/tag
+// are resolved by the number of stems generating them (ties are broken alphabetically).
+func (x *HunspellExpander) Dictionary() *Dictionary {
+	entries := x.freqs.entries(0)
+
+	for i := range entries {
+		entries[i].Freq = 0 // stem counts aren't corpus frequencies
+	}
+
+	return &Dictionary{
+		Name:    "hunspell",
+		Header:  Header{Format: FormatV2, Lang: x.opts.Lang, Tagset: x.opts.Tagset},
+		Entries: entries,
+	}
+}
+
+// expand adds all the forms of the stem to the dictionary
+func (x *HunspellExpander) expand(stem string, flags []string, morph []string) {
+	if hasFlag(flags, x.aff.forbiddenWord) || hasFlag(flags, x.aff.onlyInCompound) {
+		return
+	}
+
+	lemma := stem
+	if st := morphField(morph, "st"); st != "" {
+		lemma = st
+	}
+
+	root := hunspellForm{word: stem, flags: flags, morph: morph}
+
+	if !hasFlag(flags, x.aff.needAffix) {
+		x.add(root, lemma)
+	}
+
+	// suffixes with their continuation classes (twofold suffix stripping), then cross product prefixes
+	// of the forms with cross product suffixes
+	for _, suffixed := range x.aff.apply(root, root.flags, false, false) {
+		prefixFlags := append(append([]string{}, flags...), suffixed.flags...)
+
+		if !hasFlag(suffixed.flags, x.aff.needAffix) {
+			x.add(suffixed, lemma)
+		}
+
+		for _, twofold := range x.aff.apply(suffixed, suffixed.flags, false, false) {
+			x.add(twofold, lemma)
+
+			if suffixed.crossProduct && twofold.crossProduct {
+				x.addPrefixed(twofold, append(prefixFlags, twofold.flags...), lemma, true)
+			}
+		}
+
+		if suffixed.crossProduct {
+			x.addPrefixed(suffixed, prefixFlags, lemma, true)
+		}
+	}
+
+	x.addPrefixed(root, flags, lemma, false)
+}
+
+// addPrefixed adds the forms prefixed by the flags (only cross product prefixes for suffixed forms)
+func (x *HunspellExpander) addPrefixed(form hunspellForm, flags []string, lemma string, crossProduct bool) {
+	for _, prefixed := range x.aff.apply(form, flags, true, crossProduct) {
+		if !hasFlag(prefixed.flags, x.aff.needAffix) {
+			x.add(prefixed, lemma)
+		}
+	}
+}
+
+// add counts the form with its tag once per stem
+func (x *HunspellExpander) add(form hunspellForm, lemma string) {
+	word := strings.ToLower(form.word)
+	tag := x.tag(form.morph)
+	key := Entry{Form: word, Lemma: lemma, Tag: tag}
+
+	if x.expanded[key] {
+		return
+	}
+
+	x.expanded[key] = true
+	x.freqs.add(word, lemma, tag, 1)
+}
+
+// tag maps the last "po:" and "is:" fields of the form to a tag
+func (x *HunspellExpander) tag(morph []string) string {
+	if !x.opts.Morph {
+		return x.opts.DefaultTag
+	}
+
+	pos, inflection := morphField(morph, "po"), morphField(morph, "is")
+
+	if pos == "" {
+		return x.opts.DefaultTag
+	}
+
+	if tag, ok := x.opts.Tags[pos+":"+inflection]; ok && inflection != "" {
+		return tag
+	}
+
+	if tag, ok := x.opts.Tags[pos]; ok {
+		return tag
+	}
+
+	return strings.ToUpper(pos)
+}
+
+// apply applies the prefix (or suffix) rules of the flags to the form, only the cross product ones if crossProduct is set
+//
+// It returns generated forms with continuation flags of the rules and morphological fields of the form and the rules.
+func (aff *hunspellAff) apply(form hunspellForm, flags []string, prefix bool, crossProduct bool) []hunspellForm {
+	var forms []hunspellForm
+
+	classes := aff.suffixes
+	if prefix {
+		classes = aff.prefixes
+	}
+
+	for _, flag := range flags {
+		affix, ok := classes[flag]
+		if !ok || (crossProduct && !affix.crossProduct) {
+			continue
+		}
+
+		for _, rule := range affix.rules {
+			word, ok := rule.apply(form.word, prefix)
+			if !ok {
+				continue
+			}
+
+			morph := append(append([]string{}, form.morph...), rule.morph...)
+			forms = append(forms, hunspellForm{word: word, flags: rule.flags, morph: morph, crossProduct: affix.crossProduct})
+		}
+	}
+
+	return forms
+}
+
+// apply strips and adds the affix if the word matches the rule condition
+func (rule hunspellRule) apply(word string, prefix bool) (string, bool) {
+	runes := []rune(word)
+	n := len(rule.condition)
+
+	if len(runes) < n || len(runes) <= utf8.RuneCountInString(rule.strip) {
+		return "", false
+	}
+
+	if prefix {
+		if !strings.HasPrefix(word, rule.strip) || !matchConditions(runes[:n], rule.condition) {
+			return "", false
+		}
+
+		return rule.add + strings.TrimPrefix(word, rule.strip), true
+	}
+
+	if !strings.HasSuffix(word, rule.strip) || !matchConditions(runes[len(runes)-n:], rule.condition) {
+		return "", false
+	}
+
+	return strings.TrimSuffix(word, rule.strip) + rule.add, true
+}
+
+// matchConditions checks every character against its condition
+func matchConditions(runes []rune, conditions []hunspellCondition) bool {
+	for i, cond := range conditions {
+		if cond.any {
+			continue
+		}
+
+		if strings.ContainsRune(cond.chars, runes[i]) == cond.negate {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseAff parses .aff file directives (in the encoding set by SET directive)
+func parseAff(r io.Reader, name string) (*hunspellAff, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	aff := &hunspellAff{
+		prefixes:     make(map[string]*hunspellAffix),
+		suffixes:     make(map[string]*hunspellAffix),
+		aliasHeaders: make(map[string]bool),
+	}
+
+	// SET directive defines the encoding of the both files, so it's looked up before decoding
+	setLine := 0
+
+	for i, line := range strings.Split(string(data), "\n") {
+		if fields := strings.Fields(line); len(fields) == 2 && fields[0] == "SET" {
+			aff.encoding, setLine = fields[1], i+1
+		}
+	}
+
+	decoded, err := decodeHunspell(bytes.NewReader(data), aff.encoding)
+	if err != nil {
+		return nil, &ParseError{File: name, Line: setLine, Msg: err.Error()}
+	}
+
+	scanner := bufio.NewScanner(decoded)
+
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		fields := strings.Fields(strings.TrimPrefix(scanner.Text(), "\ufeff"))
+
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		if err := aff.parseDirective(fields); err != nil {
+			return nil, &ParseError{File: name, Line: lineNum, Msg: err.Error()}
+		}
+	}
+
+	return aff, scanner.Err()
+}
+
+// parseDirective parses a single line of .aff file
+func (aff *hunspellAff) parseDirective(fields []string) error {
+	switch fields[0] {
+	case "FLAG":
+		if len(fields) < 2 {
+			return fmt.Errorf("missing FLAG type")
+		}
+
+		aff.flagType = fields[1]
+	case "AF", "AM":
+		// the first AF/AM line is the number of aliases
+		if !aff.aliasHeaders[fields[0]] {
+			aff.aliasHeaders[fields[0]] = true
+			return nil
+		}
+
+		if len(fields) < 2 {
+			return fmt.Errorf("missing %s alias", fields[0])
+		}
+
+		if fields[0] == "AF" {
+			aff.flagAliases = append(aff.flagAliases, aff.parseFlags(fields[1]))
+		} else {
+			aff.morphAliases = append(aff.morphAliases, fields[1:])
+		}
+	case "NEEDAFFIX", "PSEUDOROOT":
+		aff.needAffix = flagArg(fields)
+	case "FORBIDDENWORD":
+		aff.forbiddenWord = flagArg(fields)
+	case "ONLYINCOMPOUND":
+		aff.onlyInCompound = flagArg(fields)
+	case "PFX", "SFX":
+		return aff.parseAffix(fields)
+	}
+
+	return nil
+}
+
+// parseAffix parses PFX/SFX class header ("SFX D Y 4") or rule ("SFX D y ied [^aeiou]y") line
+func (aff *hunspellAff) parseAffix(fields []string) error {
+	if len(fields) < 4 {
+		return fmt.Errorf("expected at least 4 fields of %s rule, got %d", fields[0], len(fields))
+	}
+
+	classes := aff.suffixes
+	if fields[0] == "PFX" {
+		classes = aff.prefixes
+	}
+
+	flag := fields[1]
+	affix, ok := classes[flag]
+
+	if !ok {
+		if fields[2] != "Y" && fields[2] != "N" {
+			return fmt.Errorf("%s rule before %s %s class header", fields[0], fields[0], flag)
+		}
+
+		classes[flag] = &hunspellAffix{crossProduct: fields[2] == "Y"}
+
+		return nil
+	}
+
+	rule := hunspellRule{strip: fields[2], add: fields[3]}
+
+	if rule.strip == "0" {
+		rule.strip = ""
+	}
+
+	if i := strings.Index(rule.add, "/"); i >= 0 {
+		rule.flags = aff.parseFlags(rule.add[i+1:])
+		rule.add = rule.add[:i]
+	}
+
+	if rule.add == "0" {
+		rule.add = ""
+	}
+
+	condition := "."
+	if len(fields) > 4 {
+		condition = fields[4]
+	}
+
+	var err error
+	if rule.condition, err = parseCondition(condition); err != nil {
+		return err
+	}
+
+	if len(fields) > 5 {
+		rule.morph = aff.expandMorph(fields[5:])
+	}
+
+	affix.rules = append(affix.rules, rule)
+
+	return nil
+}
+
+// parseStem parses .dic line, e.g. "mouse/S po:noun" or "mice po:noun is:plural st:mouse"
+func (aff *hunspellAff) parseStem(line string) (string, []string, []string, error) {
+	var morph []string
+
+	// morphological fields are separated by a tab in the stems with spaces
+	if i := strings.Index(line, "\t"); i >= 0 {
+		morph = strings.Fields(line[i+1:])
+		line = line[:i]
+	} else if fields := strings.Fields(line); len(fields) > 1 {
+		morph = fields[1:]
+		line = fields[0]
+	}
+
+	stem, flags := strings.TrimSpace(line), ""
+
+	// "/" separates the flags, unless it's escaped ("\/")
+	for i := 0; i < len(stem); i++ {
+		if stem[i] == '/' && (i == 0 || stem[i-1] != '\\') {
+			stem, flags = stem[:i], stem[i+1:]
+			break
+		}
+	}
+
+	stem = strings.ReplaceAll(stem, `\/`, "/")
+
+	if stem == "" {
+		return "", nil, nil, fmt.Errorf("empty stem in %q", line)
+	}
+
+	return stem, aff.parseFlags(flags), aff.expandMorph(morph), nil
+}
+
+// parseFlags splits the flags according to FLAG type, or resolves AF alias
+func (aff *hunspellAff) parseFlags(flags string) []string {
+	if flags == "" {
+		return nil
+	}
+
+	if len(aff.flagAliases) > 0 && isNumber(flags) {
+		if n, _ := strconv.Atoi(flags); n > 0 && n <= len(aff.flagAliases) {
+			return aff.flagAliases[n-1]
+		}
+	}
+
+	var parsed []string
+
+	switch aff.flagType {
+	case "long":
+		runes := []rune(flags)
+
+		for i := 0; i+1 < len(runes); i += 2 {
+			parsed = append(parsed, string(runes[i:i+2]))
+		}
+	case "num":
+		parsed = strings.Split(flags, ",")
+	default:
+		for _, flag := range flags {
+			parsed = append(parsed, string(flag))
+		}
+	}
+
+	return parsed
+}
+
+// expandMorph resolves AM aliases of the morphological fields
+func (aff *hunspellAff) expandMorph(morph []string) []string {
+	if len(morph) == 1 && len(aff.morphAliases) > 0 && isNumber(morph[0]) {
+		if n, _ := strconv.Atoi(morph[0]); n > 0 && n <= len(aff.morphAliases) {
+			return aff.morphAliases[n-1]
+		}
+	}
+
+	return morph
+}
+
+// parseCondition parses the rule condition, e.g. "[^aeiou]y"
+func parseCondition(condition string) ([]hunspellCondition, error) {
+	var conditions []hunspellCondition
+
+	if condition == "." {
+		return nil, nil
+	}
+
+	runes := []rune(condition)
+
+	for i := 0; i < len(runes); i++ {
+		switch runes[i] {
+		case '.':
+			conditions = append(conditions, hunspellCondition{any: true})
+		case '[':
+			end := i + 1
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+
+			if end == len(runes) {
+				return nil, fmt.Errorf("unclosed bracket in condition %q", condition)
+			}
+
+			chars := string(runes[i+1 : end])
+			negate := strings.HasPrefix(chars, "^")
+
+			conditions = append(conditions, hunspellCondition{negate: negate, chars: strings.TrimPrefix(chars, "^")})
+			i = end
+		default:
+			conditions = append(conditions, hunspellCondition{chars: string(runes[i])})
+		}
+	}
+
+	return conditions, nil
+}
+
+// decodeHunspell decodes r from the encoding set by SET directive (UTF-8 if it's empty)
+func decodeHunspell(r io.Reader, encoding string) (io.Reader, error) {
+	if encoding == "" || strings.EqualFold(encoding, "UTF-8") {
+		return r, nil
+	}
+
+	// Hunspell names (e.g. "ISO8859-1", "microsoft-cp1251") differ from IANA ones
+	name := strings.ToUpper(encoding)
+	name = strings.Replace(name, "ISO8859", "ISO-8859", 1)
+	name = strings.Replace(name, "MICROSOFT-CP", "WINDOWS-", 1)
+
+	enc, err := ianaindex.IANA.Encoding(name)
+	if err != nil || enc == nil {
+		return nil, fmt.Errorf("unsupported encoding %q", encoding)
+	}
+
+	return transform.NewReader(r, enc.NewDecoder()), nil
+}
+
+// morphField returns the value of the last morphological field with the id, e.g. "noun" for "po:noun"
+func morphField(morph []string, id string) string {
+	for i := len(morph) - 1; i >= 0; i-- {
+		if strings.HasPrefix(morph[i], id+":") {
+			return morph[i][len(id)+1:]
+		}
+	}
+
+	return ""
+}
+
+// flagArg returns the flag argument of the directive
+func flagArg(fields []string) string {
+	if len(fields) < 2 {
+		return ""
+	}
+
+	return fields[1]
+}
+
+// hasFlag checks if the flags contain the flag
+func hasFlag(flags []string, flag string) bool {
+	if flag == "" {
+		return false
+	}
+
+	for _, f := range flags {
+		if f == flag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isNumber checks if s consists of digits only
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,112 @@
+package dicts_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+var pennHunspellTags = map[string]string{
+	"noun":        "NN",
+	"noun:plural": "NNS",
+	"verb":        "VB",
+	"verb:past":   "VBD",
+	"verb:gerund": "VBG",
+	"verb:3sg":    "VBZ",
+	"adj":         "JJ",
+}
+
+func TestImportHunspell(t *testing.T) {
+	d := importHunspellFixture(t, "en", dicts.HunspellOptions{Morph: true, Tags: pennHunspellTags, Lang: "en", Tagset: "penn"})
+
+	expected := map[string]dicts.Entry{
+		"cities NNS":      {Form: "cities", Lemma: "city", Tag: "NNS"},
+		"deploy VB":       {Form: "deploy", Lemma: "deploy", Tag: "VB"},
+		"deployed VBD":    {Form: "deployed", Lemma: "deploy", Tag: "VBD"},
+		"redeploying VBG": {Form: "redeploying", Lemma: "deploy", Tag: "VBG"},
+		"undeploys VBZ":   {Form: "undeploys", Lemma: "deploy", Tag: "VBZ"},
+		"baking VBG":      {Form: "baking", Lemma: "bake", Tag: "VBG"},
+		"bakers NNS":      {Form: "bakers", Lemma: "bake", Tag: "NNS"},
+		"happiness NN":    {Form: "happiness", Lemma: "happy", Tag: "NN"},
+		"untidy JJ":       {Form: "untidy", Lemma: "tidy", Tag: "JJ"},
+		"mice NNS":        {Form: "mice", Lemma: "mouse", Tag: "NNS"},
+		"kudos NNS":       {Form: "kudos", Lemma: "kudo", Tag: "NNS"},
+	}
+
+	entries := make(map[string]dicts.Entry)
+	for _, e := range d.Entries {
+		entries[e.Form+" "+e.Tag] = e
+	}
+
+	for key, e := range expected {
+		if entries[key] != e {
+			t.Errorf("For '%s' we've got: %+v, expected: %+v", key, entries[key], e)
+		}
+	}
+
+	// NEEDAFFIX and FORBIDDENWORD stems, prefixed forms of not cross product suffixes
+	for _, key := range []string{"kudo NN", "irregardless X", "untidiness NN", "untidinesses NNS"} {
+		if _, ok := entries[key]; ok {
+			t.Errorf("Unexpected entry '%s'", key)
+		}
+	}
+
+	if len(d.Entries) != 31 {
+		t.Errorf("We've got %d entries, expected: 31", len(d.Entries))
+	}
+
+	if d.Header != (dicts.Header{Format: dicts.FormatV2, Lang: "en", Tagset: "penn"}) {
+		t.Errorf("Unexpected header: %+v", d.Header)
+	}
+}
+
+func TestImportHunspellUntagged(t *testing.T) {
+	// ISO8859-1 encoded files with long flags and flag aliases
+	d := importHunspellFixture(t, "de", dicts.HunspellOptions{DefaultTag: "NOUN"})
+
+	expected := []dicts.Entry{
+		{Form: "bäume", Lemma: "Bäume", Tag: "NOUN"},
+		{Form: "bäumee", Lemma: "Bäume", Tag: "NOUN"},
+		{Form: "bäumer", Lemma: "Bäume", Tag: "NOUN"},
+		{Form: "kräuter-buch", Lemma: "Kräuter-Buch", Tag: "NOUN"},
+		{Form: "mädchen", Lemma: "Mädchen", Tag: "NOUN"},
+		{Form: "mädchener", Lemma: "Mädchen", Tag: "NOUN"},
+	}
+
+	assertEntries(t, d.Entries, expected)
+}
+
+func TestImportHunspellMalformed(t *testing.T) {
+	aff := "SFX S Y 1\nSFX S y ies [^aeiouy\n"
+
+	_, err := dicts.ImportHunspell(strings.NewReader(aff), "bad.aff", strings.NewReader("city/S\n"), "bad.dic", dicts.HunspellOptions{})
+
+	if err == nil || err.Error() != `bad.aff:2: unclosed bracket in condition "[^aeiouy"` {
+		t.Errorf("Expected malformed condition error, got: %v", err)
+	}
+}
+
+func importHunspellFixture(t *testing.T, name string, opts dicts.HunspellOptions) *dicts.Dictionary {
+	t.Helper()
+
+	aff, err := os.Open("testdata/" + name + ".aff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer aff.Close()
+
+	dic, err := os.Open("testdata/" + name + ".dic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dic.Close()
+
+	d, err := dicts.ImportHunspell(aff, aff.Name(), dic, dic.Name(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return d
+}
@@ -0,0 +1,138 @@
+package dicts
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/smileart/lemmingo/tagset"
+)
+
+// Lint checks names
+const (
+	CheckMalformed     = "malformed"      // line skipped by the lenient parser
+	CheckDuplicate     = "duplicate"      // exact duplicate of an earlier entry
+	CheckConflict      = "conflict"       // the same form/tag with several lemmas (the last one is used on lookups)
+	CheckUnknownTag    = "unknown-tag"    // tag unknown to the dictionary tagset
+	CheckUnknownTagset = "unknown-tagset" // the dictionary tagset isn't registered (reported on line 0)
+	CheckLemmaForm     = "lemma-form"     // lemma never appears as a form of itself
+)
+
+// LintOptions configures dictionary checks
+type LintOptions struct {
+	Tagset     string // tagset to check the tags against (taken from the header if empty, skipped if there's none)
+	Lang       string // language of the tagset (taken from the header if empty)
+	LemmaForms bool   // report lemmas which never appear as forms of themselves (e.g. "mouse" without "mouse mouse NN")
+}
+
+// Issue is a single lint finding with the line of the dictionary it was found on
+type Issue struct {
+	File  string
+	Line  int
+	Check string
+	Msg   string
+}
+
+func (i *Issue) String() string {
+	return fmt.Sprintf("%s:%d: %s: %s", i.File, i.Line, i.Check, i.Msg)
+}
+
+// Lint checks the parsed dictionary for malformed lines (see Parse in lenient mode), duplicate and conflicting entries,
+// tags unknown to the tagset and (optionally) lemmas which never appear as forms of themselves.
+//
+// Entries with the same form/tag and different lemmas are reported as conflicts (e.g. "'s be VBZ" and "'s have VBZ"),
+// while the same form with different tags is fine (e.g. "annexed annex VBD" and "annexed annex VBN").
+//
+// It returns the issues sorted by line.
+func Lint(d *Dictionary, opts LintOptions) []*Issue {
+	var issues []*Issue
+
+	report := func(line int, check string, format string, args ...interface{}) {
+		issues = append(issues, &Issue{File: d.Name, Line: line, Check: check, Msg: fmt.Sprintf(format, args...)})
+	}
+
+	for _, warning := range d.Warnings {
+		report(warning.Line, CheckMalformed, "%s", warning.Msg)
+	}
+
+	seen := make(map[formTag]Entry)
+	lines := make(map[Entry]int)
+
+	for _, e := range d.Entries {
+		key := formTag{form: e.Form, tag: e.Tag}
+		exact := Entry{Form: e.Form, Lemma: e.Lemma, Tag: e.Tag}
+
+		if line, ok := lines[exact]; ok {
+			report(e.Line, CheckDuplicate, "%q duplicates line %d", entryString(e), line)
+			continue
+		}
+
+		lines[exact] = e.Line
+
+		if first, ok := seen[key]; ok {
+			report(e.Line, CheckConflict, "%q conflicts with %q on line %d", entryString(e), entryString(first), first.Line)
+			continue
+		}
+
+		seen[key] = e
+	}
+
+	tagsetName, lang := opts.Tagset, opts.Lang
+
+	if tagsetName == "" {
+		tagsetName = d.Header.Tagset
+	}
+
+	if lang == "" {
+		lang = d.Header.Lang
+	}
+
+	switch {
+	case tagsetName == "" || lang == "":
+	case !tagset.Registered(tagsetName, lang):
+		report(0, CheckUnknownTagset, "tagset %q of %q language isn't registered, the tags weren't checked", tagsetName, lang)
+	default:
+		mapPos := tagset.MapPos(tagsetName, lang)
+		reported := make(map[string]bool)
+
+		for _, e := range d.Entries {
+			if _, ok := mapPos(e.Tag); !ok && !reported[e.Tag] {
+				reported[e.Tag] = true
+				report(e.Line, CheckUnknownTag, "tag %q is unknown to %s tagset", e.Tag, tagsetName)
+			}
+		}
+	}
+
+	if opts.LemmaForms {
+		forms := make(map[string]bool, len(d.Entries))
+
+		for _, e := range d.Entries {
+			forms[e.Form] = true
+		}
+
+		reported := make(map[string]bool)
+
+		for _, e := range d.Entries {
+			lemma := strings.ToLower(e.Lemma)
+
+			// contractions have "+" joined lemmas of their words
+			if forms[lemma] || reported[lemma] || strings.Contains(lemma, "+") {
+				continue
+			}
+
+			reported[lemma] = true
+			report(e.Line, CheckLemmaForm, "lemma %q never appears as a form", e.Lemma)
+		}
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Line < issues[j].Line
+	})
+
+	return issues
+}
+
+// entryString formats the entry as a v1 dictionary line
+func entryString(e Entry) string {
+	return e.Form + " " + e.Lemma + " " + e.Tag
+}
@@ -0,0 +1,96 @@
+package dicts_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+const lintDict = `#lmm 2
+#: lang en
+#: tagset penn
+be	be	VB
+have	have	VB
+annex	annex	VB
+'s	be	VBZ
+'s	have	VBZ
+annexed	annex	VBD
+annexed	annex	VBN
+mice	mouse	NNS
+mice	mouse	NNS
+broken line
+ran	run	VBDX
+don't	do+not	VBP+RB
+`
+
+func TestLint(t *testing.T) {
+	d, err := dicts.Parse(strings.NewReader(lintDict), "lint.lmm", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		`lint.lmm:8: conflict: "'s have VBZ" conflicts with "'s be VBZ" on line 7`,
+		`lint.lmm:11: lemma-form: lemma "mouse" never appears as a form`,
+		`lint.lmm:12: duplicate: "mice mouse NNS" duplicates line 11`,
+		`lint.lmm:13: malformed: expected 3 to 6 fields (form, lemma, tag, frequency, features, note), got 2 in "broken line"`,
+		`lint.lmm:14: unknown-tag: tag "VBDX" is unknown to penn tagset`,
+		`lint.lmm:14: lemma-form: lemma "run" never appears as a form`,
+		`lint.lmm:15: unknown-tag: tag "VBP+RB" is unknown to penn tagset`,
+	}
+
+	issues := dicts.Lint(d, dicts.LintOptions{LemmaForms: true})
+
+	if len(issues) != len(expected) {
+		t.Fatalf("We've got issues: %v, expected: %v", issues, expected)
+	}
+
+	for i, issue := range issues {
+		if issue.String() != expected[i] {
+			t.Errorf("Issue #%d: we've got: %s, expected: %s", i, issue, expected[i])
+		}
+	}
+}
+
+func TestLintEmbedded(t *testing.T) {
+	r, err := dicts.Open("en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	d, err := dicts.Parse(r, "en.lmm", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conflicts := 0
+
+	for _, issue := range dicts.Lint(d, dicts.LintOptions{Tagset: "freeling", Lang: "en"}) {
+		switch issue.Check {
+		case dicts.CheckConflict:
+			conflicts++
+		case dicts.CheckMalformed, dicts.CheckUnknownTag:
+			t.Errorf("Unexpected issue: %s", issue)
+		}
+	}
+
+	if conflicts == 0 {
+		t.Errorf("Expected conflicting entries (e.g. \"'s be VBZ\" and \"'s have VBZ\") to be reported")
+	}
+}
+
+func TestLintUnknownTagset(t *testing.T) {
+	d, err := dicts.Parse(strings.NewReader("#lmm 2\n#: lang en\n#: tagset foo\nran\trun\tVBD\n"), "foo.lmm", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issues := dicts.Lint(d, dicts.LintOptions{})
+	expected := `foo.lmm:0: unknown-tagset: tagset "foo" of "en" language isn't registered, the tags weren't checked`
+
+	if len(issues) != 1 || issues[0].String() != expected {
+		t.Errorf("We've got issues: %v, expected: [%s]", issues, expected)
+	}
+}
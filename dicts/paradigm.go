@@ -0,0 +1,372 @@
+package dicts
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ParadigmOptions configures paradigm completeness check
+type ParadigmOptions struct {
+	Tagset     string  // tagset to group the tags by universal class (taken from the header if empty)
+	Lang       string  // language of the tagset (taken from the header if empty)
+	MinShare   float64 // share of the class lemmas having a tag to expect it in every paradigm of the class (0.5 by default)
+	RareShare  float64 // share of the class lemmas below which a tag is anomalous for the class (0.01 by default)
+	Candidates int     // number of suggested forms per missing cell (1 by default)
+}
+
+// Paradigm is an incomplete or anomalous paradigm of the lemma in its universal class
+type Paradigm struct {
+	Lemma       string
+	Class       string              // universal class of the paradigm tags, e.g. "VERB"
+	Cells       map[string][]string // forms by tag
+	Missing     []string            // expected tags of the class without forms
+	Rare        []string            // tags rarely seen in the class paradigms (possible tagging errors)
+	Suggestions []Entry             // rule-generated candidates for the missing cells, the rule is in the note
+}
+
+// ParadigmReport is the result of paradigm completeness check
+type ParadigmReport struct {
+	Expected  map[string][]string // expected tags by universal class, inferred from the dictionary
+	Lemmas    map[string]int      // number of lemmas by universal class
+	Paradigms []Paradigm          // incomplete or anomalous paradigms sorted by class and lemma
+}
+
+// paradigmKey is a lemma in its universal class
+type paradigmKey struct {
+	lemma string
+	class string
+}
+
+// suffixRule turns a lemma into a form by replacing its ending, e.g. "-y +ied"
+type suffixRule struct {
+	strip string
+	add   string
+}
+
+func (r suffixRule) String() string {
+	var parts []string
+
+	if r.strip != "" {
+		parts = append(parts, "-"+r.strip)
+	}
+
+	if r.add != "" {
+		parts = append(parts, "+"+r.add)
+	}
+
+	if len(parts) == 0 {
+		return "identity"
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// suffixRules counts lemma to form rules of every class tag by lemma ending (up to maxContext characters)
+type suffixRules struct {
+	counts map[string]map[string]map[suffixRule]int // tag -> lemma ending -> rule -> count
+}
+
+// maxContext is the longest lemma ending the rules are conditioned on
+const maxContext = 3
+
+// CheckParadigms groups the dictionary entries by lemma and universal class, infers the expected tags of every class
+// (the ones most of the class lemmas have, see MinShare), and reports the paradigms missing expected tags or having rare ones.
+//
+// Missing cells get candidate forms generated by the suffix rules learnt from the complete paradigms of the same class
+// (e.g. "-y +ied" for VBD of the lemmas ending with "y"). Contractions (with "+" joined lemmas or tags) are ignored.
+//
+// It returns a pointer to ParadigmReport struct.
+func CheckParadigms(d *Dictionary, opts ParadigmOptions) (*ParadigmReport, error) {
+	if opts.RareShare == 0 {
+		opts.RareShare = 0.01
+	}
+
+	if opts.Candidates == 0 {
+		opts.Candidates = 1
+	}
+
+	model, err := inferParadigms(d, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ParadigmReport{Expected: model.expected, Lemmas: model.lemmas}
+
+	for _, key := range model.order {
+		cells := model.paradigms[key]
+		p := Paradigm{Lemma: key.lemma, Class: key.class, Cells: cells}
+
+		for _, tag := range model.expected[key.class] {
+			if _, ok := cells[tag]; ok {
+				continue
+			}
+
+			p.Missing = append(p.Missing, tag)
+
+			for _, rule := range model.rules[key.class].predict(strings.ToLower(key.lemma), tag, opts.Candidates) {
+				p.Suggestions = append(p.Suggestions, Entry{
+					Form:  rule.apply(strings.ToLower(key.lemma)),
+					Lemma: key.lemma,
+					Tag:   tag,
+					Note:  "suggested by " + rule.String() + " rule",
+				})
+			}
+		}
+
+		for tag := range cells {
+			if float64(model.tagCounts[key.class][tag]) < opts.RareShare*float64(model.lemmas[key.class]) {
+				p.Rare = append(p.Rare, tag)
+			}
+		}
+
+		sort.Strings(p.Rare)
+
+		if len(p.Missing) > 0 || len(p.Rare) > 0 {
+			report.Paradigms = append(report.Paradigms, p)
+		}
+	}
+
+	sort.SliceStable(report.Paradigms, func(i, j int) bool {
+		return report.Paradigms[i].Class < report.Paradigms[j].Class
+	})
+
+	return report, nil
+}
+
+// paradigmModel is the paradigms of the dictionary with the expected tags and suffix rules of every universal class
+type paradigmModel struct {
+	paradigms map[paradigmKey]map[string][]string
+	order     []paradigmKey
+	lemmas    map[string]int            // number of lemmas by class
+	tagCounts map[string]map[string]int // number of lemmas having the tag by class
+	expected  map[string][]string       // expected tags by class
+	rules     map[string]*suffixRules   // lemma to form rules by class
+	classOf   func(tag string) string
+}
+
+// inferParadigms groups the dictionary entries into paradigms, counts their tags and learns suffix rules of every class
+func inferParadigms(d *Dictionary, opts ParadigmOptions) (*paradigmModel, error) {
+	if opts.MinShare == 0 {
+		opts.MinShare = 0.5
+	}
+
+	classOf, err := universalClass(d, opts.Tagset, opts.Lang)
+	if err != nil {
+		return nil, err
+	}
+
+	model := &paradigmModel{
+		lemmas:    make(map[string]int),
+		tagCounts: make(map[string]map[string]int),
+		expected:  make(map[string][]string),
+		rules:     make(map[string]*suffixRules),
+		classOf:   classOf,
+	}
+
+	model.paradigms, model.order = groupParadigms(d, classOf)
+
+	for _, key := range model.order {
+		model.lemmas[key.class]++
+
+		if model.tagCounts[key.class] == nil {
+			model.tagCounts[key.class] = make(map[string]int)
+			model.rules[key.class] = &suffixRules{counts: make(map[string]map[string]map[suffixRule]int)}
+		}
+
+		for tag, forms := range model.paradigms[key] {
+			model.tagCounts[key.class][tag]++
+
+			for _, form := range forms {
+				model.rules[key.class].learn(strings.ToLower(key.lemma), form, tag)
+			}
+		}
+	}
+
+	for class, counts := range model.tagCounts {
+		for tag, n := range counts {
+			if float64(n) >= opts.MinShare*float64(model.lemmas[class]) {
+				model.expected[class] = append(model.expected[class], tag)
+			}
+		}
+
+		sort.Strings(model.expected[class])
+	}
+
+	return model, nil
+}
+
+func (p Paradigm) String() string {
+	var parts []string
+
+	if len(p.Missing) > 0 {
+		parts = append(parts, "missing "+strings.Join(p.Missing, ", "))
+	}
+
+	if len(p.Rare) > 0 {
+		parts = append(parts, "rare "+strings.Join(p.Rare, ", "))
+	}
+
+	return fmt.Sprintf("%s %s: %s", p.Lemma, p.Class, strings.Join(parts, "; "))
+}
+
+// universalClass returns the function mapping the tags to their universal class (header tagset/language are used by default)
+func universalClass(d *Dictionary, tagsetName string, lang string) (func(string) string, error) {
+	if tagsetName == "" {
+		tagsetName = d.Header.Tagset
+	}
+
+	if lang == "" {
+		lang = d.Header.Lang
+	}
+
+	if tagsetName == "" || lang == "" {
+		return nil, errors.New("Tagset and language of the dictionary are required to group the tags by universal class!")
+	}
+
+	mapPos, err := tagsetMapping(tagsetName, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(tag string) string {
+		if class, ok := mapPos(tag); ok {
+			return class
+		}
+
+		return "X"
+	}, nil
+}
+
+// groupParadigms groups the entries forms by lemma/class and tag
+//
+// It returns the paradigms and their keys sorted by lemma.
+func groupParadigms(d *Dictionary, classOf func(string) string) (map[paradigmKey]map[string][]string, []paradigmKey) {
+	paradigms := make(map[paradigmKey]map[string][]string)
+
+	var order []paradigmKey
+
+	for _, e := range d.Entries {
+		if strings.Contains(e.Lemma, "+") || strings.Contains(e.Tag, "+") || e.MultiWord() {
+			continue
+		}
+
+		key := paradigmKey{lemma: e.Lemma, class: classOf(e.Tag)}
+
+		if paradigms[key] == nil {
+			paradigms[key] = make(map[string][]string)
+			order = append(order, key)
+		}
+
+		if !containsString(paradigms[key][e.Tag], e.Form) {
+			paradigms[key][e.Tag] = append(paradigms[key][e.Tag], e.Form)
+		}
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return order[i].lemma < order[j].lemma
+	})
+
+	return paradigms, order
+}
+
+// learn counts the rule turning the lemma into the form for the tag and every lemma ending
+func (r *suffixRules) learn(lemma string, form string, tag string) {
+	rule := newSuffixRule(lemma, form)
+
+	if r.counts[tag] == nil {
+		r.counts[tag] = make(map[string]map[suffixRule]int)
+	}
+
+	for _, ending := range endings(lemma) {
+		if r.counts[tag][ending] == nil {
+			r.counts[tag][ending] = make(map[suffixRule]int)
+		}
+
+		r.counts[tag][ending][rule]++
+	}
+}
+
+// predict returns up to n most frequent rules for the tag applicable to the lemma,
+// conditioned on the longest lemma ending seen at least twice
+func (r *suffixRules) predict(lemma string, tag string, n int) []suffixRule {
+	for _, ending := range endings(lemma) {
+		var (
+			rules []suffixRule
+			total int
+		)
+
+		counts := r.counts[tag][ending]
+
+		for rule, count := range counts {
+			if strings.HasSuffix(lemma, rule.strip) {
+				rules = append(rules, rule)
+				total += count
+			}
+		}
+
+		if total < 2 && ending != "" {
+			continue
+		}
+
+		sort.Slice(rules, func(i, j int) bool {
+			if counts[rules[i]] != counts[rules[j]] {
+				return counts[rules[i]] > counts[rules[j]]
+			}
+
+			return rules[i].String() < rules[j].String()
+		})
+
+		if len(rules) > n {
+			rules = rules[:n]
+		}
+
+		return rules
+	}
+
+	return nil
+}
+
+// apply replaces the lemma ending according to the rule
+func (r suffixRule) apply(lemma string) string {
+	return strings.TrimSuffix(lemma, r.strip) + r.add
+}
+
+// newSuffixRule creates the rule turning the lemma into the form after their longest common prefix
+func newSuffixRule(lemma string, form string) suffixRule {
+	l, f := []rune(lemma), []rune(form)
+	i := 0
+
+	for i < len(l) && i < len(f) && l[i] == f[i] {
+		i++
+	}
+
+	return suffixRule{strip: string(l[i:]), add: string(f[i:])}
+}
+
+// endings returns the lemma endings from the longest (up to maxContext characters) to the empty one
+func endings(lemma string) []string {
+	runes := []rune(lemma)
+
+	var result []string
+
+	for n := maxContext; n >= 0; n-- {
+		if n <= len(runes) {
+			result = append(result, string(runes[len(runes)-n:]))
+		}
+	}
+
+	return result
+}
+
+// containsString checks if the items contain the item
+func containsString(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+
+	return false
+}
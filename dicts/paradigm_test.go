@@ -0,0 +1,107 @@
+package dicts_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+const paradigmDict = `#lmm 2
+#: lang en
+#: tagset penn
+walk	walk	VB
+walked	walk	VBD
+walks	walk	VBZ
+walking	walk	VBG
+talk	talk	VB
+talked	talk	VBD
+talks	talk	VBZ
+jump	jump	VB
+jumped	jump	VBD
+jumps	jump	VBZ
+deploy	deploy	VB
+deploys	deploy	VBZ
+deploy	deploy	NNP
+carry	carry	VB
+carried	carry	VBD
+carries	carry	VBZ
+marry	marry	VB
+married	marry	VBD
+marries	marry	VBZ
+enjoy	enjoy	VB
+enjoyed	enjoy	VBD
+enjoys	enjoy	VBZ
+annoy	annoy	VB
+annoyed	annoy	VBD
+annoys	annoy	VBZ
+bury	bury	VB
+buries	bury	VBZ
+dog	dog	NN
+dogs	dog	NNS
+don't	do+not	VBP+RB
+`
+
+func TestCheckParadigms(t *testing.T) {
+	d, err := dicts.Parse(strings.NewReader(paradigmDict), "paradigms.lmm", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := dicts.CheckParadigms(d, dicts.ParadigmOptions{RareShare: 0.2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string][]string{"NOUN": {"NN", "NNP", "NNS"}, "VERB": {"VB", "VBD", "VBZ"}}
+
+	if !reflect.DeepEqual(report.Expected, expected) {
+		t.Errorf("We've got expected tags: %v, expected: %v", report.Expected, expected)
+	}
+
+	var paradigms []string
+	for _, p := range report.Paradigms {
+		paradigms = append(paradigms, p.String())
+	}
+
+	expectedParadigms := []string{
+		"deploy NOUN: missing NN, NNS",
+		"dog NOUN: missing NNP",
+		"bury VERB: missing VBD",
+		"deploy VERB: missing VBD",
+		"walk VERB: rare VBG",
+	}
+
+	if !reflect.DeepEqual(paradigms, expectedParadigms) {
+		t.Fatalf("We've got paradigms: %q, expected: %q", paradigms, expectedParadigms)
+	}
+
+	// "deploy" follows "enjoy" and "annoy" (the longest ending seen twice), not "carry" and "marry"
+	suggestions := map[string]dicts.Entry{
+		"bury":   {Form: "buried", Lemma: "bury", Tag: "VBD", Note: "suggested by -y +ied rule"},
+		"deploy": {Form: "deployed", Lemma: "deploy", Tag: "VBD", Note: "suggested by +ed rule"},
+	}
+
+	for _, p := range report.Paradigms[2:4] {
+		if len(p.Suggestions) != 1 || p.Suggestions[0] != suggestions[p.Lemma] {
+			t.Errorf("For '%s' we've got suggestions: %+v, expected: %+v", p.Lemma, p.Suggestions, suggestions[p.Lemma])
+		}
+	}
+}
+
+func TestCheckParadigmsWithoutTagset(t *testing.T) {
+	d := &dicts.Dictionary{Entries: []dicts.Entry{{Form: "dogs", Lemma: "dog", Tag: "NNS"}}}
+
+	if _, err := dicts.CheckParadigms(d, dicts.ParadigmOptions{RareShare: 0.2}); err == nil {
+		t.Errorf("The method was supposed to return an error for the dictionary without tagset!")
+	}
+}
+
+func TestCheckParadigmsUnknownTagset(t *testing.T) {
+	d := &dicts.Dictionary{Entries: []dicts.Entry{{Form: "dogs", Lemma: "dog", Tag: "NNS"}}}
+
+	if _, err := dicts.CheckParadigms(d, dicts.ParadigmOptions{Tagset: "foo", Lang: "en", RareShare: 0.2}); err == nil {
+		t.Errorf("The method was supposed to return an error for the unknown tagset!")
+	}
+}
@@ -0,0 +1,253 @@
+package dicts
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Entry is a single dictionary record: inflected form, its canonical form (lemma) and PoS tag
+type Entry struct {
+	Form  string
+	Lemma string
+	Tag   string
+	Freq  int    // corpus frequency of the form/tag (v2, 0 when unknown)
+	Feats string // morphological features, e.g. "Tense=Past|VerbForm=Fin" (v2)
+	Note  string // free text note (v2)
+	Line  int    // line number in the source dictionary (0 for the entries created in memory)
+}
+
+// MultiWord tells if the entry is a multi-word expression (e.g. "in spite of").
+func (e Entry) MultiWord() bool {
+	return strings.Contains(e.Form, " ")
+}
+
+// Dictionary is a parsed Lemmingo dictionary
+type Dictionary struct {
+	Name     string        // file name (or any other source name) used in diagnostics
+	Header   Header        // metadata declared in the dictionary header (v2+)
+	Entries  []Entry       // entries in the order of appearance
+	Rules    []Rule        // lemmatisation rules of the compact dictionary (v2, see Compact)
+	Warnings []*ParseError // lines skipped by the lenient parser
+}
+
+// ParseError describes a malformed dictionary line
+type ParseError struct {
+	File string
+	Line int
+	Msg  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Msg)
+}
+
+// Parse reads the dictionary from r in the following format: "inflected_word<separator>canonical_form<separator>PoS_tag"
+// (see FormatV1 and FormatV2 for the header and optional columns).
+//
+// Separator is either a tab (when there's at least one on the line) or one or more spaces.
+// Tab separated lines could have multi-word expressions with single spaces between words, e.g. "ran out<tab>run out<tab>VBD".
+// Blank lines and lines starting with "#" are ignored (use "\#" to start a word with a literal "#").
+//
+// In strict mode the first malformed line fails parsing with "file:line: message" error,
+// otherwise malformed lines are skipped and collected in Dictionary.Warnings.
+// Unsupported format version fails parsing in both modes.
+//
+// It returns a pointer to Dictionary struct.
+func Parse(r io.Reader, name string, strict bool) (*Dictionary, error) {
+	if name == "" {
+		name = "<reader>"
+	}
+
+	d := &Dictionary{Name: name, Header: Header{Format: FormatV1}}
+	scanner := bufio.NewScanner(r)
+	started := false
+
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := scanner.Text()
+
+		if lineNum == 1 {
+			line = strings.TrimPrefix(line, "\ufeff") // UTF-8 BOM
+		}
+
+		trimmed := strings.TrimSpace(line)
+
+		if trimmed == "" {
+			continue
+		}
+
+		var (
+			entry Entry
+			msg   string
+		)
+
+		switch {
+		case strings.HasPrefix(trimmed, formatMarker):
+			if started {
+				msg = "format marker should be the first line of the dictionary"
+				break
+			}
+
+			version, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(trimmed, formatMarker)))
+			if err != nil || version < FormatV1 || version > FormatV2 {
+				return nil, &ParseError{File: name, Line: lineNum, Msg: fmt.Sprintf("unsupported format %q", trimmed)}
+			}
+
+			d.Header.Format = version
+		case strings.HasPrefix(trimmed, headerPrefix) && d.Header.Format >= FormatV2:
+			msg = parseDirective(d, trimmed, len(d.Entries) > 0)
+		case strings.HasPrefix(trimmed, "#"):
+			// comment
+		default:
+			entry, msg = parseEntry(line, d.Header.Format)
+
+			if msg == "" {
+				entry.Line = lineNum
+				d.Entries = append(d.Entries, entry)
+			}
+		}
+
+		started = true
+
+		if msg != "" {
+			perr := &ParseError{File: name, Line: lineNum, Msg: msg}
+
+			if strict {
+				return nil, perr
+			}
+
+			d.Warnings = append(d.Warnings, perr)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// parseDirective parses "#: key value" header directive into the dictionary header, or "#: rule TAG suffix lemma" into its rules
+//
+// It returns the error message if the directive is malformed.
+func parseDirective(d *Dictionary, directive string, afterEntries bool) string {
+	if afterEntries {
+		return fmt.Sprintf("header directive after dictionary entries in %q", directive)
+	}
+
+	fields := strings.Fields(strings.TrimPrefix(directive, headerPrefix))
+
+	if len(fields) > 0 && fields[0] == "rule" {
+		if len(fields) != 4 {
+			return fmt.Sprintf("expected rule directive \"#: rule TAG suffix lemma\", got %q", directive)
+		}
+
+		d.Rules = append(d.Rules, Rule{Tag: fields[1], Suffix: column(fields[2]), Lemma: column(fields[3])})
+
+		return ""
+	}
+
+	if len(fields) != 2 {
+		return fmt.Sprintf("expected header directive \"#: key value\", got %q", directive)
+	}
+
+	if fields[0] == "lang" {
+		if _, err := language.Parse(fields[1]); err != nil {
+			return fmt.Sprintf("invalid language tag %q", fields[1])
+		}
+	}
+
+	if !d.Header.set(fields[0], fields[1]) {
+		return fmt.Sprintf("unknown header directive %q", fields[0])
+	}
+
+	return ""
+}
+
+// parseEntry parses a single dictionary entry line according to the format version.
+//
+// It returns the entry and the error message if the line is malformed.
+func parseEntry(line string, format int) (Entry, string) {
+	maxFields := 3
+	if format >= FormatV2 {
+		maxFields = 6
+	}
+
+	fields := splitFields(line, maxFields)
+
+	if len(fields) < 3 || len(fields) > maxFields {
+		if maxFields == 3 {
+			return Entry{}, fmt.Sprintf("expected 3 fields (form, lemma, tag), got %d in %q", len(fields), line)
+		}
+
+		return Entry{}, fmt.Sprintf("expected 3 to %d fields (form, lemma, tag, frequency, features, note), got %d in %q", maxFields, len(fields), line)
+	}
+
+	for i, field := range fields {
+		if field == "" || (i < 3 && field == emptyColumn && format >= FormatV2) {
+			return Entry{}, fmt.Sprintf("empty field #%d in %q", i+1, line)
+		}
+	}
+
+	entry := Entry{Form: unescape(fields[0]), Lemma: unescape(fields[1]), Tag: fields[2]}
+
+	if len(fields) > 3 && fields[3] != emptyColumn {
+		freq, err := strconv.Atoi(fields[3])
+		if err != nil || freq < 0 {
+			return Entry{}, fmt.Sprintf("invalid frequency %q in %q", fields[3], line)
+		}
+
+		entry.Freq = freq
+	}
+
+	if len(fields) > 4 && fields[4] != emptyColumn {
+		for _, feat := range strings.Split(fields[4], "|") {
+			if kv := strings.SplitN(feat, "=", 2); len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+				return Entry{}, fmt.Sprintf("invalid features %q in %q", fields[4], line)
+			}
+		}
+
+		entry.Feats = fields[4]
+	}
+
+	if len(fields) > 5 && fields[5] != emptyColumn {
+		entry.Note = fields[5]
+	}
+
+	return entry, ""
+}
+
+// splitFields splits the line by tabs if there are any, otherwise by runs of spaces.
+//
+// When splitting by spaces the last of maxFields fields takes the rest of the line (so v2 notes could have spaces),
+// v1 lines are split completely to report extra fields.
+func splitFields(line string, maxFields int) []string {
+	if strings.Contains(line, "\t") {
+		fields := strings.Split(strings.TrimSpace(line), "\t")
+
+		// normalise spaces between the words of multi-word expressions
+		for i := range fields {
+			fields[i] = strings.Join(strings.Fields(fields[i]), " ")
+		}
+
+		return fields
+	}
+
+	fields := strings.Fields(line)
+
+	if maxFields > 3 && len(fields) > maxFields {
+		rest := strings.TrimSpace(line)
+
+		for i := 0; i < maxFields-1; i++ {
+			rest = strings.TrimSpace(strings.TrimPrefix(rest, fields[i]))
+		}
+
+		fields = append(fields[:maxFields-1], rest)
+	}
+
+	return fields
+}
@@ -0,0 +1,119 @@
+package dicts_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+type ParseTestCase struct {
+	line  string
+	entry dicts.Entry
+	err   string
+}
+
+var parseCases = []ParseTestCase{
+	{
+		line:  "am be VBP",
+		entry: dicts.Entry{Form: "am", Lemma: "be", Tag: "VBP", Line: 1},
+	},
+	{
+		line:  "are  be   VBP  ",
+		entry: dicts.Entry{Form: "are", Lemma: "be", Tag: "VBP", Line: 1},
+	},
+	{
+		line:  "abandoning\tabandon\tVBG\r",
+		entry: dicts.Entry{Form: "abandoning", Lemma: "abandon", Tag: "VBG", Line: 1},
+	},
+	{
+		line:  "\ufeffcaresses caress NNS",
+		entry: dicts.Entry{Form: "caresses", Lemma: "caress", Tag: "NNS", Line: 1},
+	},
+	{
+		line:  `\# # #`,
+		entry: dicts.Entry{Form: "#", Lemma: "#", Tag: "#", Line: 1},
+	},
+	{
+		line:  "ran  out\trun out\tVBD",
+		entry: dicts.Entry{Form: "ran out", Lemma: "run out", Tag: "VBD", Line: 1},
+	},
+	{
+		line: "marketing marketing",
+		err:  "test.lmm:1: expected 3 fields (form, lemma, tag), got 2 in \"marketing marketing\"",
+	},
+	{
+		line: "marketing marketing NN extra",
+		err:  "test.lmm:1: expected 3 fields (form, lemma, tag), got 4 in \"marketing marketing NN extra\"",
+	},
+	{
+		line: "marketing\t\tNN",
+		err:  "test.lmm:1: empty field #2 in \"marketing\\t\\tNN\"",
+	},
+}
+
+func TestParseLines(t *testing.T) {
+	for _, c := range parseCases {
+		d, err := dicts.Parse(strings.NewReader(c.line), "test.lmm", true)
+
+		if c.err != "" {
+			if err == nil || err.Error() != c.err {
+				t.Errorf("For the line %q we've got error: %v, expected: %s", c.line, err, c.err)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("For the line %q we've got unexpected error: %s", c.line, err)
+			continue
+		}
+
+		if len(d.Entries) != 1 || d.Entries[0] != c.entry {
+			t.Errorf("For the line %q we've got: %+v, expected: %+v", c.line, d.Entries, c.entry)
+		}
+	}
+}
+
+func TestParseLenient(t *testing.T) {
+	data := "# comment\n\nam be VBP\nbroken\n   \nare be VBP\nthree fields NN here\n"
+
+	d, err := dicts.Parse(strings.NewReader(data), "test.lmm", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(d.Entries) != 2 || d.Entries[0].Line != 3 || d.Entries[1].Line != 6 {
+		t.Errorf("Unexpected entries: %+v", d.Entries)
+	}
+
+	if len(d.Warnings) != 2 || d.Warnings[0].Line != 4 || d.Warnings[1].Line != 7 {
+		t.Errorf("Unexpected warnings: %v", d.Warnings)
+	}
+}
+
+func TestParseStrict(t *testing.T) {
+	_, err := dicts.Parse(strings.NewReader("am be VBP\n\nbroken\n"), "", true)
+
+	perr, ok := err.(*dicts.ParseError)
+	if !ok || perr.Line != 3 || !strings.HasPrefix(err.Error(), "<reader>:3: ") {
+		t.Errorf("Expected parse error on line 3, got: %v", err)
+	}
+}
+
+func TestParseEmbedded(t *testing.T) {
+	dict, err := dicts.Open("en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dict.Close()
+
+	d, err := dicts.Parse(dict, "en.lmm", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(d.Entries) != 89168 {
+		t.Errorf("Expected 89168 entries in en.lmm, got: %d", len(d.Entries))
+	}
+}
@@ -0,0 +1,83 @@
+package dicts
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/fs"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/ulikunitz/xz"
+)
+
+// Magic bytes of the supported compression formats
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	xzMagic   = []byte{0xfd, 0x37, 0x7a, 0x58, 0x5a, 0x00}
+)
+
+// Decompress detects gzip/zstd/xz compression of the data in r by its magic bytes and transparently decompresses it.
+//
+// Uncompressed data is passed through as it is.
+//
+// It returns a reader with the decompressed data, which should be closed after the usage (it doesn't close r).
+func Decompress(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+
+	// Peek fails on data shorter than the longest magic, which is fine since we compare what we've got
+	magic, _ := br.Peek(len(xzMagic))
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		return gzip.NewReader(br)
+	case bytes.HasPrefix(magic, zstdMagic):
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+
+		return zr.IOReadCloser(), nil
+	case bytes.HasPrefix(magic, xzMagic):
+		xr, err := xz.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+
+		return io.NopCloser(xr), nil
+	}
+
+	return io.NopCloser(br), nil
+}
+
+// OpenFS opens the dictionary file by name from the fsys (e.g. embed.FS, os.DirFS or archive/zip reader),
+// transparently decompressing it if needed.
+//
+// It returns a reader with the decompressed dictionary data, which should be closed after the usage.
+func OpenFS(fsys fs.FS, name string) (io.ReadCloser, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	dict, err := Decompress(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &fsDict{ReadCloser: dict, file: file}, nil
+}
+
+// fsDict closes both the decompressor and the underlying file
+type fsDict struct {
+	io.ReadCloser
+	file fs.File
+}
+
+func (d *fsDict) Close() error {
+	defer d.file.Close()
+
+	return d.ReadCloser.Close()
+}
@@ -0,0 +1,110 @@
+package dicts_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+	"testing/fstest"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/smileart/lemmingo/dicts"
+	"github.com/ulikunitz/xz"
+)
+
+const sampleDict = "am be VBP\nare be VBP\n"
+
+type CompressTestCase struct {
+	name     string
+	compress func([]byte) []byte
+}
+
+var compressCases = []CompressTestCase{
+	{
+		name:     "plain",
+		compress: func(data []byte) []byte { return data },
+	},
+	{
+		name: "gzip",
+		compress: func(data []byte) []byte {
+			var b bytes.Buffer
+			w := gzip.NewWriter(&b)
+			w.Write(data)
+			w.Close()
+			return b.Bytes()
+		},
+	},
+	{
+		name: "zstd",
+		compress: func(data []byte) []byte {
+			var b bytes.Buffer
+			w, _ := zstd.NewWriter(&b)
+			w.Write(data)
+			w.Close()
+			return b.Bytes()
+		},
+	},
+	{
+		name: "xz",
+		compress: func(data []byte) []byte {
+			var b bytes.Buffer
+			w, _ := xz.NewWriter(&b)
+			w.Write(data)
+			w.Close()
+			return b.Bytes()
+		},
+	},
+}
+
+func TestDecompress(t *testing.T) {
+	for _, c := range compressCases {
+		r, err := dicts.Decompress(bytes.NewReader(c.compress([]byte(sampleDict))))
+		if err != nil {
+			t.Fatalf("For %s data we've got an error: %s", c.name, err)
+		}
+
+		data, err := io.ReadAll(r)
+		r.Close()
+
+		if err != nil || string(data) != sampleDict {
+			t.Errorf("For %s data we've got: %q (%v), expected: %q.", c.name, data, err, sampleDict)
+		}
+	}
+}
+
+func TestDecompressShort(t *testing.T) {
+	r, err := dicts.Decompress(bytes.NewReader([]byte("a")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if data, _ := io.ReadAll(r); string(data) != "a" {
+		t.Errorf("Data shorter than magic bytes got mangled: %q", data)
+	}
+}
+
+func TestOpenFS(t *testing.T) {
+	fsys := fstest.MapFS{}
+
+	for _, c := range compressCases {
+		fsys["dicts/"+c.name+".lmm"] = &fstest.MapFile{Data: c.compress([]byte(sampleDict))}
+	}
+
+	for _, c := range compressCases {
+		r, err := dicts.OpenFS(fsys, "dicts/"+c.name+".lmm")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, _ := io.ReadAll(r)
+		r.Close()
+
+		if string(data) != sampleDict {
+			t.Errorf("For %s file we've got: %q, expected: %q.", c.name, data, sampleDict)
+		}
+	}
+
+	if _, err := dicts.OpenFS(fsys, "dicts/missing.lmm"); err == nil {
+		t.Errorf("The method was supposed to return an error on missing file!")
+	}
+}
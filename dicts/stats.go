@@ -0,0 +1,168 @@
+package dicts
+
+import (
+	"sort"
+
+	"github.com/smileart/lemmingo/tagset"
+)
+
+// StatsOptions configures dictionary statistics
+type StatsOptions struct {
+	Tagset string // tagset to group the tags by universal class (taken from the header if empty, skipped if there's none)
+	Lang   string // language of the tagset (taken from the header if empty)
+}
+
+// Stats is the dictionary statistics in total, by tag and by universal class
+type Stats struct {
+	Counts
+	Tags     map[string]*Counts `json:"tags"`
+	Classes  map[string]*Counts `json:"classes,omitempty"`       // by universal class (only when the tagset is known)
+	Unmapped []string           `json:"unmapped_tags,omitempty"` // tags unknown to the tagset, sorted
+}
+
+// Counts are the figures of the dictionary or a group of its entries
+type Counts struct {
+	Entries       int     `json:"entries"`
+	Forms         int     `json:"forms"`           // distinct forms
+	Lemmas        int     `json:"lemmas"`          // distinct lemmas
+	FormsPerLemma float64 `json:"forms_per_lemma"` // average number of distinct forms of a lemma
+	Ambiguous     int     `json:"ambiguous_forms"` // forms having several lemmas (e.g. "saw" of "see" and "saw")
+	Ambiguity     float64 `json:"ambiguity"`       // share of ambiguous forms
+}
+
+// countsBuilder collects the lemmas of every form of the group
+type countsBuilder struct {
+	entries int
+	forms   map[string]map[string]bool // form -> lemmas
+	lemmas  map[string]map[string]bool // lemma -> forms
+}
+
+// Stats counts the entries, forms, lemmas, forms per lemma and ambiguous forms (having several lemmas) of the dictionary
+// in total, by tag and by universal class (when the tagset is known), and lists the tags unknown to the tagset
+// (all the tags if the tagset isn't registered).
+//
+// It returns a pointer to Stats struct.
+func (d *Dictionary) Stats(opts StatsOptions) *Stats {
+	tagsetName, lang := opts.Tagset, opts.Lang
+
+	if tagsetName == "" {
+		tagsetName = d.Header.Tagset
+	}
+
+	if lang == "" {
+		lang = d.Header.Lang
+	}
+
+	var mapPos func(string) (string, bool)
+
+	switch {
+	case tagsetName == "" || lang == "":
+	case !tagset.Registered(tagsetName, lang):
+		// none of the tags is known to the unregistered tagset
+		mapPos = func(string) (string, bool) { return "", false }
+	default:
+		mapPos = tagset.MapPos(tagsetName, lang)
+	}
+
+	total := newCountsBuilder()
+	tags := make(map[string]*countsBuilder)
+	classes := make(map[string]*countsBuilder)
+	unmapped := make(map[string]bool)
+
+	add := func(groups map[string]*countsBuilder, key string, e Entry) {
+		if groups[key] == nil {
+			groups[key] = newCountsBuilder()
+		}
+
+		groups[key].add(e)
+	}
+
+	for _, e := range d.Entries {
+		total.add(e)
+		add(tags, e.Tag, e)
+
+		if mapPos == nil {
+			continue
+		}
+
+		class, ok := mapPos(e.Tag)
+		if !ok {
+			class = "X"
+			unmapped[e.Tag] = true
+		}
+
+		add(classes, class, e)
+	}
+
+	stats := &Stats{Counts: total.counts(), Tags: make(map[string]*Counts, len(tags))}
+
+	for tag, b := range tags {
+		counts := b.counts()
+		stats.Tags[tag] = &counts
+	}
+
+	if mapPos != nil {
+		stats.Classes = make(map[string]*Counts, len(classes))
+
+		for class, b := range classes {
+			counts := b.counts()
+			stats.Classes[class] = &counts
+		}
+	}
+
+	for tag := range unmapped {
+		stats.Unmapped = append(stats.Unmapped, tag)
+	}
+
+	sort.Strings(stats.Unmapped)
+
+	return stats
+}
+
+// newCountsBuilder creates an empty group
+func newCountsBuilder() *countsBuilder {
+	return &countsBuilder{forms: make(map[string]map[string]bool), lemmas: make(map[string]map[string]bool)}
+}
+
+// add adds the entry to the group
+func (b *countsBuilder) add(e Entry) {
+	b.entries++
+
+	if b.forms[e.Form] == nil {
+		b.forms[e.Form] = make(map[string]bool)
+	}
+
+	if b.lemmas[e.Lemma] == nil {
+		b.lemmas[e.Lemma] = make(map[string]bool)
+	}
+
+	b.forms[e.Form][e.Lemma] = true
+	b.lemmas[e.Lemma][e.Form] = true
+}
+
+// counts calculates the figures of the group
+func (b *countsBuilder) counts() Counts {
+	c := Counts{Entries: b.entries, Forms: len(b.forms), Lemmas: len(b.lemmas)}
+
+	for _, lemmas := range b.forms {
+		if len(lemmas) > 1 {
+			c.Ambiguous++
+		}
+	}
+
+	forms := 0
+
+	for _, lemmaForms := range b.lemmas {
+		forms += len(lemmaForms)
+	}
+
+	if c.Lemmas > 0 {
+		c.FormsPerLemma = float64(forms) / float64(c.Lemmas)
+	}
+
+	if c.Forms > 0 {
+		c.Ambiguity = float64(c.Ambiguous) / float64(c.Forms)
+	}
+
+	return c
+}
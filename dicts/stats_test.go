@@ -0,0 +1,59 @@
+package dicts_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+func TestStats(t *testing.T) {
+	data := "#lmm 2\n#: lang en\n#: tagset penn\n" +
+		"saw\tsee\tVBD\nsaw\tsaw\tVBD\nsee\tsee\tVB\nsaws\tsaw\tNNS\nsaw\tsaw\tNN\ncan't\tcan+not\tMD+RB\n"
+
+	d, err := dicts.Parse(strings.NewReader(data), "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats := d.Stats(dicts.StatsOptions{})
+
+	expected := dicts.Counts{Entries: 6, Forms: 4, Lemmas: 3, FormsPerLemma: 5.0 / 3, Ambiguous: 1, Ambiguity: 0.25}
+
+	if stats.Counts != expected {
+		t.Errorf("We've got: %+v, expected: %+v", stats.Counts, expected)
+	}
+
+	if vbd := stats.Tags["VBD"]; vbd == nil || vbd.Forms != 1 || vbd.Lemmas != 2 || vbd.Ambiguous != 1 {
+		t.Errorf("Unexpected VBD counts: %+v", vbd)
+	}
+
+	if verb := stats.Classes["VERB"]; verb == nil || verb.Entries != 3 || verb.Forms != 2 || verb.FormsPerLemma != 1.5 {
+		t.Errorf("Unexpected VERB counts: %+v", verb)
+	}
+
+	if noun := stats.Classes["NOUN"]; noun == nil || noun.Forms != 2 || noun.Lemmas != 1 {
+		t.Errorf("Unexpected NOUN counts: %+v", noun)
+	}
+
+	if len(stats.Unmapped) != 1 || stats.Unmapped[0] != "MD+RB" {
+		t.Errorf("We've got unmapped tags: %v, expected: [MD+RB]", stats.Unmapped)
+	}
+
+	d.Header = dicts.Header{}
+
+	if stats := d.Stats(dicts.StatsOptions{}); stats.Classes != nil || stats.Unmapped != nil {
+		t.Errorf("Universal classes were not supposed to be counted without tagset!")
+	}
+}
+
+func TestStatsUnknownTagset(t *testing.T) {
+	d := &dicts.Dictionary{Entries: []dicts.Entry{{Form: "saw", Lemma: "see", Tag: "VBD"}, {Form: "saws", Lemma: "saw", Tag: "NNS"}}}
+
+	stats := d.Stats(dicts.StatsOptions{Tagset: "foo", Lang: "en"})
+
+	if !reflect.DeepEqual(stats.Unmapped, []string{"NNS", "VBD"}) || stats.Classes["X"] == nil || stats.Classes["X"].Entries != 2 {
+		t.Errorf("We've got unexpected stats: %+v", stats)
+	}
+}
@@ -0,0 +1,135 @@
+package dicts
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// Inflection class suffixes of the paradigm templates, e.g. "verb-regular" or "noun-irregular"
+const (
+	Regular   = "regular"   // every form is generated by the suffix rules of the class
+	Irregular = "irregular" // some forms are given as overrides, the rest is generated by the suffix rules
+)
+
+// Templates are paradigm templates by inflection class ("<universal class>-regular" or "<universal class>-irregular",
+// e.g. "verb-regular"), inferred from the dictionary: the tags of every class are its expected tags (see CheckParadigms),
+// and the forms are generated with the suffix rules learnt from the class paradigms.
+type Templates struct {
+	model *paradigmModel
+}
+
+// InferTemplates infers paradigm templates of every universal class from the dictionary (see ParadigmOptions).
+//
+// It returns a pointer to Templates struct.
+func InferTemplates(d *Dictionary, opts ParadigmOptions) (*Templates, error) {
+	model, err := inferParadigms(d, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Templates{model: model}, nil
+}
+
+// Classes returns the names of the inflection classes, e.g. "noun-irregular", "noun-regular", "verb-irregular", "verb-regular".
+func (t *Templates) Classes() []string {
+	var classes []string
+
+	for class, tags := range t.model.expected {
+		if len(tags) > 0 {
+			name := strings.ToLower(class)
+			classes = append(classes, name+"-"+Irregular, name+"-"+Regular)
+		}
+	}
+
+	sort.Strings(classes)
+
+	return classes
+}
+
+// Tags returns the tags generated for the inflection class, e.g. "VB VBD VBG VBN VBP VBZ" for "verb-regular".
+func (t *Templates) Tags(class string) ([]string, error) {
+	universal, _, err := t.parseClass(class)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.model.expected[universal], nil
+}
+
+// Generate generates the paradigm entries of the lemma with the tags of the inflection class.
+//
+// Overrides are "TAG=form" pairs replacing the generated forms (repeat the tag for several forms, e.g. "VBD=dreamed", "VBD=dreamt"),
+// or adding the forms of the class tags that aren't expected (e.g. "NNP=Mice"). Irregular classes require at least one override.
+//
+// It returns the entries sorted by form and tag.
+func (t *Templates) Generate(lemma string, class string, overrides ...string) ([]Entry, error) {
+	universal, irregular, err := t.parseClass(class)
+	if err != nil {
+		return nil, err
+	}
+
+	forms := make(map[string][]string)
+
+	for _, override := range overrides {
+		pair := strings.SplitN(override, "=", 2)
+
+		if len(pair) != 2 || strings.TrimSpace(pair[0]) == "" || strings.TrimSpace(pair[1]) == "" {
+			return nil, errors.New("Paradigm override `" + override + "` should be a TAG=form pair!")
+		}
+
+		tag, form := strings.TrimSpace(pair[0]), strings.ToLower(strings.TrimSpace(pair[1]))
+
+		if t.model.tagCounts[universal][tag] == 0 {
+			return nil, errors.New("Tag `" + tag + "` isn't used by `" + class + "` paradigms!")
+		}
+
+		forms[tag] = append(forms[tag], form)
+	}
+
+	if irregular && len(forms) == 0 {
+		return nil, errors.New("Irregular paradigm `" + class + "` requires overrides!")
+	}
+
+	base := strings.ToLower(lemma)
+
+	for _, tag := range t.model.expected[universal] {
+		if _, ok := forms[tag]; ok {
+			continue
+		}
+
+		rules := t.model.rules[universal].predict(base, tag, 1)
+		if len(rules) == 0 {
+			return nil, errors.New("No `" + tag + "` rule matches `" + lemma + "`, use an override!")
+		}
+
+		forms[tag] = []string{rules[0].apply(base)}
+	}
+
+	var entries []Entry
+
+	for tag, tagForms := range forms {
+		for _, form := range tagForms {
+			entries = append(entries, Entry{Form: form, Lemma: lemma, Tag: tag})
+		}
+	}
+
+	sortEntries(entries)
+
+	return entries, nil
+}
+
+// parseClass splits the inflection class name into universal class and irregular flag
+func (t *Templates) parseClass(class string) (string, bool, error) {
+	i := strings.LastIndex(class, "-")
+
+	if i > 0 {
+		universal, kind := strings.ToUpper(class[:i]), class[i+1:]
+
+		if len(t.model.expected[universal]) > 0 && (kind == Regular || kind == Irregular) {
+			return universal, kind == Irregular, nil
+		}
+	}
+
+	return "", false, errors.New("Inflection class `" + class + "` was not found!")
+}
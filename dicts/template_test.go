@@ -0,0 +1,122 @@
+package dicts_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+const templateDict = `#lmm 2
+#: lang en
+#: tagset penn
+walk	walk	VB
+walked	walk	VBD
+walks	walk	VBZ
+walking	walk	VBG
+enjoy	enjoy	VB
+enjoyed	enjoy	VBD
+enjoys	enjoy	VBZ
+enjoying	enjoy	VBG
+annoy	annoy	VB
+annoyed	annoy	VBD
+annoys	annoy	VBZ
+annoying	annoy	VBG
+carry	carry	VB
+carried	carry	VBD
+carries	carry	VBZ
+carrying	carry	VBG
+marry	marry	VB
+married	marry	VBD
+marries	marry	VBZ
+marrying	marry	VBG
+dog	dog	NN
+dogs	dog	NNS
+box	box	NN
+boxes	box	NNS
+fox	fox	NN
+foxes	fox	NNS
+Dogs	dog	NNP
+`
+
+type TemplateTestCase struct {
+	lemma     string
+	class     string
+	overrides []string
+	expected  []string
+}
+
+func TestTemplates(t *testing.T) {
+	d, err := dicts.Parse(strings.NewReader(templateDict), "templates.lmm", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	templates, err := dicts.InferTemplates(d, dicts.ParadigmOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	classes := []string{"noun-irregular", "noun-regular", "verb-irregular", "verb-regular"}
+
+	if !reflect.DeepEqual(templates.Classes(), classes) {
+		t.Errorf("We've got classes: %v, expected: %v", templates.Classes(), classes)
+	}
+
+	testCases := []TemplateTestCase{
+		{lemma: "deploy", class: "verb-regular", expected: []string{"deploy VB", "deployed VBD", "deploying VBG", "deploys VBZ"}},
+		{lemma: "hurry", class: "verb-regular", expected: []string{"hurried VBD", "hurries VBZ", "hurry VB", "hurrying VBG"}},
+		{lemma: "tax", class: "noun-regular", expected: []string{"tax NN", "taxes NNS"}},
+		{lemma: "mouse", class: "noun-irregular", overrides: []string{"NNS=mice"}, expected: []string{"mice NNS", "mouse NN"}},
+		{lemma: "dream", class: "verb-irregular", overrides: []string{"VBD=dreamed", "VBD=dreamt"}, expected: []string{"dream VB", "dreamed VBD", "dreaming VBG", "dreams VBZ", "dreamt VBD"}},
+	}
+
+	for _, tc := range testCases {
+		entries, err := templates.Generate(tc.lemma, tc.class, tc.overrides...)
+		if err != nil {
+			t.Fatalf("For '%s' (%s) we've got an error: %s", tc.lemma, tc.class, err)
+		}
+
+		var forms []string
+		for _, e := range entries {
+			if e.Lemma != tc.lemma {
+				t.Errorf("For '%s' we've got an entry with '%s' lemma", tc.lemma, e.Lemma)
+			}
+
+			forms = append(forms, e.Form+" "+e.Tag)
+		}
+
+		if !reflect.DeepEqual(forms, tc.expected) {
+			t.Errorf("For '%s' (%s) we've got: %q, expected: %q", tc.lemma, tc.class, forms, tc.expected)
+		}
+	}
+}
+
+func TestTemplatesErrors(t *testing.T) {
+	d, err := dicts.Parse(strings.NewReader(templateDict), "templates.lmm", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	templates, err := dicts.InferTemplates(d, dicts.ParadigmOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errorCases := map[string][]string{
+		"Inflection class `adj-regular` was not found!":           {"good", "adj-regular"},
+		"Inflection class `verb` was not found!":                  {"go", "verb"},
+		"Irregular paradigm `noun-irregular` requires overrides!": {"mouse", "noun-irregular"},
+		"Paradigm override `mice` should be a TAG=form pair!":     {"mouse", "noun-irregular", "mice"},
+		"Tag `VBD` isn't used by `noun-irregular` paradigms!":     {"mouse", "noun-irregular", "VBD=mice"},
+	}
+
+	for expected, args := range errorCases {
+		_, err := templates.Generate(args[0], args[1], args[2:]...)
+
+		if err == nil || err.Error() != expected {
+			t.Errorf("Expected error: %s, got: %v", expected, err)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package dicts
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Change kinds of the dictionaries diff
+const (
+	Added   = "+"
+	Removed = "-"
+	Changed = "~"
+)
+
+// Change is a difference of two dictionaries for a single form/tag
+type Change struct {
+	Kind     string // Added, Removed or Changed
+	Form     string
+	Tag      string
+	Lemma    string // lemma in the new dictionary (empty for removed entries)
+	OldLemma string // lemma in the old dictionary (empty for added entries)
+}
+
+func (c Change) String() string {
+	switch c.Kind {
+	case Added:
+		return fmt.Sprintf("+ %s %s %s", c.Form, c.Lemma, c.Tag)
+	case Removed:
+		return fmt.Sprintf("- %s %s %s", c.Form, c.OldLemma, c.Tag)
+	}
+
+	return fmt.Sprintf("~ %s %s -> %s %s", c.Form, c.OldLemma, c.Lemma, c.Tag)
+}
+
+// Sort sorts the dictionary entries by form, tag and lemma (the canonical order of the formatted dictionaries).
+func (d *Dictionary) Sort() {
+	sortEntries(d.Entries)
+}
+
+// Dedupe removes exact duplicate entries, and if conflicts is true also resolves conflicting lemmas of the same form/tag.
+//
+// The entry with the highest frequency is kept, or the last one (the one used on lookups by the dictionary loader).
+//
+// It returns the removed entries.
+func (d *Dictionary) Dedupe(conflicts bool) []Entry {
+	kept := make(map[Entry]int)
+	drop := make(map[int]bool)
+
+	for i, e := range d.Entries {
+		key := Entry{Form: e.Form, Lemma: e.Lemma, Tag: e.Tag}
+		if conflicts {
+			key.Lemma = ""
+		}
+
+		j, ok := kept[key]
+
+		switch {
+		case !ok:
+			kept[key] = i
+		case d.Entries[j].Freq > e.Freq:
+			drop[i] = true
+		default:
+			drop[j], kept[key] = true, i
+		}
+	}
+
+	var (
+		entries []Entry
+		removed []Entry
+	)
+
+	for i, e := range d.Entries {
+		if drop[i] {
+			removed = append(removed, e)
+		} else {
+			entries = append(entries, e)
+		}
+	}
+
+	d.Entries = entries
+
+	return removed
+}
+
+// Diff compares the lemmas of every form/tag of the old and updated dictionaries (as they're used on lookups).
+//
+// It returns the changes sorted by form and tag.
+func Diff(old *Dictionary, updated *Dictionary) []Change {
+	oldLemmas, newLemmas := lookupIndex(old), lookupIndex(updated)
+
+	var changes []Change
+
+	for key, lemma := range newLemmas {
+		oldLemma, ok := oldLemmas[key]
+
+		switch {
+		case !ok:
+			changes = append(changes, Change{Kind: Added, Form: key.form, Tag: key.tag, Lemma: lemma})
+		case oldLemma != lemma:
+			changes = append(changes, Change{Kind: Changed, Form: key.form, Tag: key.tag, Lemma: lemma, OldLemma: oldLemma})
+		}
+	}
+
+	for key, oldLemma := range oldLemmas {
+		if _, ok := newLemmas[key]; !ok {
+			changes = append(changes, Change{Kind: Removed, Form: key.form, Tag: key.tag, OldLemma: oldLemma})
+		}
+	}
+
+	sortChanges(changes)
+
+	return changes
+}
+
+// Merge merges the dictionaries into a new sorted one, entries of the later dictionaries override the lemmas
+// of the same form/tag in the earlier ones (e.g. a project specific dictionary over the default one).
+//
+// Header fields of the first dictionary are filled from the later ones if empty, the highest format version wins.
+// The rules of compact dictionaries (see Compact) are kept, so the entries of the others override the rule lemmas.
+//
+// It returns a pointer to the merged Dictionary (and the error if the compact dictionaries have different rules,
+// or the rules would get the tagset of the other dictionaries).
+func Merge(ds ...*Dictionary) (*Dictionary, error) {
+	merged := &Dictionary{Name: "merged", Header: Header{Format: FormatV1}}
+	index := make(map[formTag]int)
+
+	for _, d := range ds {
+		mergeHeader(&merged.Header, d.Header)
+
+		if len(d.Rules) > 0 {
+			if merged.Rules != nil && !sameRules(merged.Rules, d.Rules) {
+				return nil, errors.New("Compact dictionaries with different rules can't be merged, merge the full ones and compact the result instead!")
+			}
+
+			merged.Rules = d.Rules
+		}
+
+		for _, e := range d.Entries {
+			key := formTag{form: e.Form, tag: e.Tag}
+
+			if i, ok := index[key]; ok {
+				merged.Entries[i] = e
+				continue
+			}
+
+			index[key] = len(merged.Entries)
+			merged.Entries = append(merged.Entries, e)
+		}
+	}
+
+	for i := range merged.Entries {
+		merged.Entries[i].Line = 0
+	}
+
+	if len(merged.Rules) > 0 && merged.Header.Tagset != "" {
+		return nil, errors.New("Compact dictionary rules can't be merged with `" + merged.Header.Tagset + "` tagset dictionaries, merge the full ones and compact the result instead!")
+	}
+
+	merged.Sort()
+
+	return merged, nil
+}
+
+// sameRules tells if the rule sets are the same
+func sameRules(a []Rule, b []Rule) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mergeHeader fills empty fields of the header h from the header other
+func mergeHeader(h *Header, other Header) {
+	if other.Format > h.Format {
+		h.Format = other.Format
+	}
+
+	for _, field := range []struct {
+		dst *string
+		src string
+	}{
+		{&h.Lang, other.Lang},
+		{&h.Tagset, other.Tagset},
+		{&h.Licence, other.Licence},
+		{&h.Version, other.Version},
+	} {
+		if *field.dst == "" {
+			*field.dst = field.src
+		}
+	}
+}
+
+// lookupIndex maps every form/tag of the dictionary to its lemma, the last entry wins as on the dictionary loading
+func lookupIndex(d *Dictionary) map[formTag]string {
+	index := make(map[formTag]string, len(d.Entries))
+
+	for _, e := range d.Entries {
+		index[formTag{form: e.Form, tag: e.Tag}] = e.Lemma
+	}
+
+	return index
+}
+
+// sortChanges sorts the changes by form and tag
+func sortChanges(changes []Change) {
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Form != changes[j].Form {
+			return changes[i].Form < changes[j].Form
+		}
+
+		return changes[i].Tag < changes[j].Tag
+	})
+}
@@ -0,0 +1,127 @@
+package dicts_test
+
+import (
+	"testing"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+func TestDedupe(t *testing.T) {
+	d := &dicts.Dictionary{Entries: []dicts.Entry{
+		{Form: "'s", Lemma: "be", Tag: "VBZ", Freq: 10},
+		{Form: "'s", Lemma: "have", Tag: "VBZ"},
+		{Form: "mice", Lemma: "mouse", Tag: "NNS"},
+		{Form: "mice", Lemma: "mouse", Tag: "NNS"},
+		{Form: "saw", Lemma: "saw", Tag: "VBD"},
+		{Form: "saw", Lemma: "see", Tag: "VBD"},
+	}}
+
+	removed := d.Dedupe(false)
+
+	if len(removed) != 1 || len(d.Entries) != 5 {
+		t.Fatalf("We've got removed: %+v, kept: %+v", removed, d.Entries)
+	}
+
+	removed = d.Dedupe(true)
+
+	expected := []dicts.Entry{
+		{Form: "'s", Lemma: "be", Tag: "VBZ", Freq: 10},
+		{Form: "mice", Lemma: "mouse", Tag: "NNS"},
+		{Form: "saw", Lemma: "see", Tag: "VBD"},
+	}
+
+	if len(removed) != 2 {
+		t.Errorf("We've got removed: %+v, expected 2 entries", removed)
+	}
+
+	assertEntries(t, d.Entries, expected)
+}
+
+func TestDiffAndMerge(t *testing.T) {
+	old := &dicts.Dictionary{Header: dicts.Header{Format: dicts.FormatV1}, Entries: []dicts.Entry{
+		{Form: "mice", Lemma: "mice", Tag: "NNS"},
+		{Form: "ran", Lemma: "run", Tag: "VBD"},
+		{Form: "went", Lemma: "go", Tag: "VBD"},
+	}}
+
+	patch := &dicts.Dictionary{Header: dicts.Header{Format: dicts.FormatV2, Lang: "en"}, Entries: []dicts.Entry{
+		{Form: "mice", Lemma: "mouse", Tag: "NNS", Line: 3},
+		{Form: "geese", Lemma: "goose", Tag: "NNS", Line: 4},
+	}}
+
+	merged, err := dicts.Merge(old, patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertEntries(t, merged.Entries, []dicts.Entry{
+		{Form: "geese", Lemma: "goose", Tag: "NNS"},
+		{Form: "mice", Lemma: "mouse", Tag: "NNS"},
+		{Form: "ran", Lemma: "run", Tag: "VBD"},
+		{Form: "went", Lemma: "go", Tag: "VBD"},
+	})
+
+	if merged.Header != (dicts.Header{Format: dicts.FormatV2, Lang: "en"}) {
+		t.Errorf("Unexpected header: %+v", merged.Header)
+	}
+
+	merged.Entries = merged.Entries[:3]
+
+	var changes []string
+	for _, c := range dicts.Diff(old, merged) {
+		changes = append(changes, c.String())
+	}
+
+	expected := []string{"+ geese goose NNS", "~ mice mice -> mouse NNS", "- went go VBD"}
+
+	if len(changes) != len(expected) {
+		t.Fatalf("We've got changes: %q, expected: %q", changes, expected)
+	}
+
+	for i := range expected {
+		if changes[i] != expected[i] {
+			t.Errorf("Change #%d: we've got: %s, expected: %s", i, changes[i], expected[i])
+		}
+	}
+}
+
+func TestMergeCompact(t *testing.T) {
+	full := &dicts.Dictionary{Header: dicts.Header{Format: dicts.FormatV2, Lang: "en"}, Entries: []dicts.Entry{
+		{Form: "cats", Lemma: "cat", Tag: "NNS"},
+		{Form: "dogs", Lemma: "dog", Tag: "NNS"},
+		{Form: "birds", Lemma: "bird", Tag: "NNS"},
+		{Form: "mice", Lemma: "mouse", Tag: "NNS"},
+	}}
+
+	compact, err := dicts.Compact(full, dicts.CompactOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	patch := &dicts.Dictionary{Header: dicts.Header{Format: dicts.FormatV2}, Entries: []dicts.Entry{{Form: "geese", Lemma: "goose", Tag: "NNS"}}}
+
+	merged, err := dicts.Merge(compact, patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(merged.Rules) == 0 || len(merged.Entries) != len(compact.Entries)+1 {
+		t.Fatalf("We've got rules: %+v, entries: %+v", merged.Rules, merged.Entries)
+	}
+
+	if lemma, ok := dicts.NewRuleSet(merged.Rules).Lemma("cats", "NNS"); !ok || lemma != "cat" {
+		t.Errorf("For the word 'cats' we've got: '%s' lemma, expected: 'cat'", lemma)
+	}
+
+	other := &dicts.Dictionary{Header: dicts.Header{Format: dicts.FormatV2}, Rules: []dicts.Rule{{Tag: "NNS", Suffix: "s", Lemma: ""}, {Tag: "VBD", Suffix: "ed", Lemma: ""}}}
+
+	if _, err := dicts.Merge(compact, other); err == nil {
+		t.Errorf("The method was supposed to return an error for the compact dictionaries with different rules!")
+	}
+
+	tagged := &dicts.Dictionary{Header: dicts.Header{Format: dicts.FormatV2, Tagset: "penn"}, Entries: []dicts.Entry{{Form: "geese", Lemma: "goose", Tag: "NNS"}}}
+
+	if _, err := dicts.Merge(compact, tagged); err == nil {
+		t.Errorf("The method was supposed to return an error for the rules merged with a tagged dictionary!")
+	}
+}
@@ -0,0 +1,130 @@
+package dicts
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/smileart/lemmingo/tagset"
+)
+
+// UniMorphOptions configures UniMorph paradigm files import
+type UniMorphOptions struct {
+	Target string // target tagset: "universal" (default) or a tagset name supported by tagset.MapUniMorph (e.g. "penn")
+	Lang   string // language of the dictionary (e.g. "en"), used for the target tagset and the dictionary header
+	Notes  bool   // keep the original UniMorph feature bundle in the note column (e.g. "V;PST")
+}
+
+// UniMorphReport lists what couldn't be mapped to the target tagset on import
+type UniMorphReport struct {
+	Bundles  map[string]int // feature bundles without a tag in the target tagset (skipped), with the number of lines
+	Features map[string]int // features unknown to UniMorph schema (e.g. language specific ones), with the number of lines
+}
+
+// UniMorphImporter accumulates lemma/form/features triples from one or more UniMorph files
+//
+// REF: https://unimorph.github.io/
+type UniMorphImporter struct {
+	opts   UniMorphOptions
+	freqs  *frequencies
+	notes  map[formTag]string
+	report *UniMorphReport
+}
+
+// NewUniMorphImporter creates a new UniMorph importer with the options provided.
+func NewUniMorphImporter(opts UniMorphOptions) *UniMorphImporter {
+	if opts.Target == "" {
+		opts.Target = "universal"
+	}
+
+	return &UniMorphImporter{
+		opts:   opts,
+		freqs:  newFrequencies(),
+		notes:  make(map[formTag]string),
+		report: &UniMorphReport{Bundles: make(map[string]int), Features: make(map[string]int)},
+	}
+}
+
+// ImportUniMorph reads a single UniMorph file from r and returns the resulting dictionary with unmapped features report.
+func ImportUniMorph(r io.Reader, name string, opts UniMorphOptions) (*Dictionary, *UniMorphReport, error) {
+	imp := NewUniMorphImporter(opts)
+
+	if err := imp.Read(r, name); err != nil {
+		return nil, nil, err
+	}
+
+	return imp.Dictionary(), imp.Report(), nil
+}
+
+// Read maps the "lemma<tab>form<tab>features" lines read from r to dictionary entries, name is used in "file:line" errors.
+//
+// Lines with feature bundles having no tag in the target tagset are skipped and counted in the report.
+func (imp *UniMorphImporter) Read(r io.Reader, name string) error {
+	scanner := bufio.NewScanner(r)
+
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" {
+			continue
+		}
+
+		cols := strings.Split(line, "\t")
+
+		if len(cols) != 3 {
+			return &ParseError{File: name, Line: lineNum, Msg: fmt.Sprintf("expected 3 UniMorph columns (lemma, form, features), got %d", len(cols))}
+		}
+
+		lemma, form, bundle := strings.TrimSpace(cols[0]), strings.ToLower(strings.TrimSpace(cols[1])), strings.TrimSpace(cols[2])
+
+		tag, unknown, ok, err := tagset.MapUniMorph(bundle, imp.opts.Target, imp.opts.Lang)
+		if err != nil {
+			return err
+		}
+
+		for _, feature := range unknown {
+			imp.report.Features[feature]++
+		}
+
+		if !ok {
+			imp.report.Bundles[bundle]++
+			continue
+		}
+
+		imp.freqs.add(form, lemma, tag, 1)
+
+		if imp.opts.Notes {
+			imp.notes[formTag{form: form, tag: tag}] = bundle
+		}
+	}
+
+	return scanner.Err()
+}
+
+// Dictionary returns v2 dictionary with the imported entries, conflicting lemmas of the same form/tag are resolved
+// by the number of paradigms they appear in.
+func (imp *UniMorphImporter) Dictionary() *Dictionary {
+	entries := imp.freqs.entries(0)
+
+	for i := range entries {
+		entries[i].Freq = 0 // paradigm counts aren't corpus frequencies
+		entries[i].Note = imp.notes[formTag{form: entries[i].Form, tag: entries[i].Tag}]
+	}
+
+	tagsetName := imp.opts.Target
+	if tagsetName == "universal" {
+		tagsetName = ""
+	}
+
+	return &Dictionary{
+		Name:    "unimorph",
+		Header:  Header{Format: FormatV2, Lang: imp.opts.Lang, Tagset: tagsetName},
+		Entries: entries,
+	}
+}
+
+// Report returns unmapped bundles and unknown features seen so far.
+func (imp *UniMorphImporter) Report() *UniMorphReport {
+	return imp.report
+}
@@ -0,0 +1,83 @@
+package dicts_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+const sampleUniMorph = `run	ran	V;PST
+run	run	V;NFIN
+run	running	V;V.PTCP;PRS
+run	runs	V;3;SG;PRS
+run	run	V;V.PTCP;PST
+mouse	mice	N;PL
+mouse	mouse	N;SG
+good	better	ADJ;CMPR;LGSPEC1
+hello	hello	INTJ;LGSPEC2
+well	well	X;FOO
+`
+
+func TestImportUniMorphPenn(t *testing.T) {
+	d, report, err := dicts.ImportUniMorph(strings.NewReader(sampleUniMorph), "eng", dicts.UniMorphOptions{Target: "penn", Lang: "en", Notes: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []dicts.Entry{
+		{Form: "better", Lemma: "good", Tag: "JJR", Note: "ADJ;CMPR;LGSPEC1"},
+		{Form: "hello", Lemma: "hello", Tag: "UH", Note: "INTJ;LGSPEC2"},
+		{Form: "mice", Lemma: "mouse", Tag: "NNS", Note: "N;PL"},
+		{Form: "mouse", Lemma: "mouse", Tag: "NN", Note: "N;SG"},
+		{Form: "ran", Lemma: "run", Tag: "VBD", Note: "V;PST"},
+		{Form: "run", Lemma: "run", Tag: "VB", Note: "V;NFIN"},
+		{Form: "run", Lemma: "run", Tag: "VBN", Note: "V;V.PTCP;PST"},
+		{Form: "running", Lemma: "run", Tag: "VBG", Note: "V;V.PTCP;PRS"},
+		{Form: "runs", Lemma: "run", Tag: "VBZ", Note: "V;3;SG;PRS"},
+	}
+
+	assertEntries(t, d.Entries, expected)
+
+	if d.Header.Tagset != "penn" || d.Header.Lang != "en" {
+		t.Errorf("Unexpected header: %+v", d.Header)
+	}
+
+	if len(report.Bundles) != 1 || report.Bundles["X;FOO"] != 1 {
+		t.Errorf("Unexpected unmapped bundles: %v", report.Bundles)
+	}
+
+	if len(report.Features) != 4 || report.Features["LGSPEC1"] != 1 || report.Features["LGSPEC2"] != 1 || report.Features["X"] != 1 || report.Features["FOO"] != 1 {
+		t.Errorf("Unexpected unknown features: %v", report.Features)
+	}
+}
+
+func TestImportUniMorphUniversal(t *testing.T) {
+	d, _, err := dicts.ImportUniMorph(strings.NewReader(sampleUniMorph), "eng", dicts.UniMorphOptions{Lang: "en"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []dicts.Entry{
+		{Form: "better", Lemma: "good", Tag: "ADJ"},
+		{Form: "hello", Lemma: "hello", Tag: "X"},
+		{Form: "mice", Lemma: "mouse", Tag: "NOUN"},
+		{Form: "mouse", Lemma: "mouse", Tag: "NOUN"},
+		{Form: "ran", Lemma: "run", Tag: "VERB"},
+		{Form: "run", Lemma: "run", Tag: "VERB"},
+		{Form: "running", Lemma: "run", Tag: "VERB"},
+		{Form: "runs", Lemma: "run", Tag: "VERB"},
+	}
+
+	assertEntries(t, d.Entries, expected)
+}
+
+func TestImportUniMorphErrors(t *testing.T) {
+	if _, _, err := dicts.ImportUniMorph(strings.NewReader("run\tran\n"), "eng", dicts.UniMorphOptions{}); err == nil || !strings.HasPrefix(err.Error(), "eng:1: ") {
+		t.Errorf("Expected malformed line error, got: %v", err)
+	}
+
+	if _, _, err := dicts.ImportUniMorph(strings.NewReader(sampleUniMorph), "eng", dicts.UniMorphOptions{Target: "penn", Lang: "uk"}); err == nil {
+		t.Errorf("Expected unsupported target tagset error!")
+	}
+}
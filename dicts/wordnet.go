@@ -0,0 +1,215 @@
+package dicts
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+)
+
+// WordNetOptions configures WordNet database import
+type WordNetOptions struct {
+	Index bool // also add the lemmas of index.* files as their own forms (e.g. "dog dog n")
+}
+
+// wordnetFiles are the exception list and index file suffixes of WordNet syntactic categories
+var wordnetFiles = []struct {
+	name string
+	tag  string
+}{
+	{name: "noun", tag: "n"},
+	{name: "verb", tag: "v"},
+	{name: "adj", tag: "a"},
+	{name: "adv", tag: "r"},
+}
+
+// morphyRule is a WordNet morphy detachment rule: inflectional suffix and its replacement
+type morphyRule struct {
+	suffix string
+	ending string
+}
+
+// morphyRules are the detachment rules of WordNet morphy by syntactic category
+// REF: https://wordnet.princeton.edu/documentation/morphy7wn
+var morphyRules = map[string][]morphyRule{
+	"n": {
+		{suffix: "s", ending: ""},
+		{suffix: "ses", ending: "s"},
+		{suffix: "xes", ending: "x"},
+		{suffix: "zes", ending: "z"},
+		{suffix: "ches", ending: "ch"},
+		{suffix: "shes", ending: "sh"},
+		{suffix: "men", ending: "man"},
+		{suffix: "ies", ending: "y"},
+	},
+	"v": {
+		{suffix: "s", ending: ""},
+		{suffix: "ies", ending: "y"},
+		{suffix: "es", ending: "e"},
+		{suffix: "es", ending: ""},
+		{suffix: "ed", ending: "e"},
+		{suffix: "ed", ending: ""},
+		{suffix: "ing", ending: "e"},
+		{suffix: "ing", ending: ""},
+	},
+	"a": {
+		{suffix: "er", ending: ""},
+		{suffix: "est", ending: ""},
+		{suffix: "er", ending: "e"},
+		{suffix: "est", ending: "e"},
+	},
+}
+
+// ImportWordNet reads exception lists (noun.exc, verb.exc, adj.exc, adv.exc) from WordNet database directory fsys
+// (e.g. os.DirFS("/usr/share/wordnet/dict")) and returns a dictionary tagged with WordNet syntactic categories: n, v, a, r.
+//
+// Exception lists map irregular forms to their base forms (the first one is used if there're several),
+// when Index option is on the lemmas of index.noun, index.verb, index.adj and index.adv files are added as well.
+// Collocations ("attorneys_general") become multi-word expressions ("attorneys general").
+//
+// REF: https://wordnet.princeton.edu/documentation/wndb5wn
+func ImportWordNet(fsys fs.FS, opts WordNetOptions) (*Dictionary, error) {
+	lemmas := make(map[formTag]string)
+	found := false
+
+	for _, file := range wordnetFiles {
+		if opts.Index {
+			ok, err := readWordNet(fsys, "index."+file.name, file.tag, lemmas, parseWordNetIndex)
+			if err != nil {
+				return nil, err
+			}
+
+			found = found || ok
+		}
+
+		// exceptions take precedence over the index lemmas (e.g. "data datum n")
+		ok, err := readWordNet(fsys, file.name+".exc", file.tag, lemmas, parseWordNetException)
+		if err != nil {
+			return nil, err
+		}
+
+		found = found || ok
+	}
+
+	if !found {
+		return nil, errors.New("WordNet exception lists were not found!")
+	}
+
+	entries := make([]Entry, 0, len(lemmas))
+
+	for key, lemma := range lemmas {
+		entries = append(entries, Entry{Form: key.form, Lemma: lemma, Tag: key.tag})
+	}
+
+	sortEntries(entries)
+
+	return &Dictionary{
+		Name:    "wordnet",
+		Header:  Header{Format: FormatV2, Lang: "en", Tagset: "wordnet"},
+		Entries: entries,
+	}, nil
+}
+
+// WordNetPos maps the tag to WordNet syntactic category (n, v, a, r) understood by Morphy,
+// the tag could be a WordNet one, Universal Tagset (or UPOS) one or a Penn Treebank one (e.g. "NNS", "VBD").
+//
+// It returns an empty string for the tags of other categories.
+func WordNetPos(tag string) string {
+	switch tag = strings.ToUpper(tag); {
+	case tag == "N" || tag == "NOUN" || tag == "PROPN" || strings.HasPrefix(tag, "NN"):
+		return "n"
+	case tag == "V" || tag == "VERB" || tag == "AUX" || strings.HasPrefix(tag, "VB"):
+		return "v"
+	case tag == "A" || tag == "S" || tag == "ADJ" || strings.HasPrefix(tag, "JJ"):
+		return "a"
+	case tag == "R" || tag == "ADV" || strings.HasPrefix(tag, "RB"):
+		return "r"
+	}
+
+	return ""
+}
+
+// Morphy applies WordNet morphy detachment rules of the syntactic category pos (n, v, a, r) to the word,
+// e.g. "boxes" gives "boxe", "box" (nouns ending with "ful" are detached before "ful": "boxesful" gives "boxful").
+//
+// It returns the base form candidates in the rules order, which should be checked against a list of known lemmas.
+func Morphy(word string, pos string) []string {
+	var candidates []string
+
+	suffix := ""
+	if pos == "n" && strings.HasSuffix(word, "ful") {
+		word, suffix = strings.TrimSuffix(word, "ful"), "ful"
+	}
+
+	for _, rule := range morphyRules[pos] {
+		if strings.HasSuffix(word, rule.suffix) && len(word) > len(rule.suffix) {
+			candidates = append(candidates, strings.TrimSuffix(word, rule.suffix)+rule.ending+suffix)
+		}
+	}
+
+	return candidates
+}
+
+// readWordNet reads WordNet file name line by line with parse into lemmas
+//
+// It returns false if the file doesn't exist.
+func readWordNet(fsys fs.FS, name string, tag string, lemmas map[formTag]string, parse func(string) (string, string, bool)) (bool, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		// license header of the database files
+		if line == "" || strings.HasPrefix(line, "  ") {
+			continue
+		}
+
+		form, lemma, ok := parse(line)
+		if !ok {
+			return false, &ParseError{File: name, Line: lineNum, Msg: fmt.Sprintf("malformed line %q", line)}
+		}
+
+		lemmas[formTag{form: collocation(form), tag: tag}] = collocation(lemma)
+	}
+
+	return true, scanner.Err()
+}
+
+// parseWordNetException parses exception list line: "inflected_form base_form [base_form...]"
+func parseWordNetException(line string) (string, string, bool) {
+	fields := strings.Fields(line)
+
+	if len(fields) < 2 {
+		return "", "", false
+	}
+
+	return fields[0], fields[1], true
+}
+
+// parseWordNetIndex parses index file line: "lemma pos synset_cnt p_cnt [ptr_symbol...] sense_cnt tagsense_cnt synset_offset..."
+func parseWordNetIndex(line string) (string, string, bool) {
+	fields := strings.Fields(line)
+
+	if len(fields) < 2 {
+		return "", "", false
+	}
+
+	return fields[0], fields[0], true
+}
+
+// collocation turns WordNet collocation into a multi-word expression
+func collocation(word string) string {
+	return strings.ToLower(strings.ReplaceAll(word, "_", " "))
+}
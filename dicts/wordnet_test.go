@@ -0,0 +1,109 @@
+package dicts_test
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"github.com/smileart/lemmingo/dicts"
+)
+
+func TestImportWordNet(t *testing.T) {
+	d, err := dicts.ImportWordNet(os.DirFS("testdata/wordnet"), dicts.WordNetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []dicts.Entry{
+		{Form: "aardwolves", Lemma: "aardwolf", Tag: "n"},
+		{Form: "ate", Lemma: "eat", Tag: "v"},
+		{Form: "attorneys general", Lemma: "attorney general", Tag: "n"},
+		{Form: "axes", Lemma: "ax", Tag: "n"},
+		{Form: "best", Lemma: "well", Tag: "r"},
+		{Form: "better", Lemma: "good", Tag: "a"},
+		{Form: "broke", Lemma: "break", Tag: "v"},
+		{Form: "data", Lemma: "datum", Tag: "n"},
+		{Form: "mice", Lemma: "mouse", Tag: "n"},
+		{Form: "went", Lemma: "go", Tag: "v"},
+		{Form: "worse", Lemma: "bad", Tag: "a"},
+	}
+
+	assertEntries(t, d.Entries, expected)
+
+	if d.Header != (dicts.Header{Format: dicts.FormatV2, Lang: "en", Tagset: "wordnet"}) {
+		t.Errorf("Unexpected header: %+v", d.Header)
+	}
+}
+
+func TestImportWordNetIndex(t *testing.T) {
+	d, err := dicts.ImportWordNet(os.DirFS("testdata/wordnet"), dicts.WordNetOptions{Index: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lemmas := make(map[string]string)
+	for _, e := range d.Entries {
+		lemmas[e.Form+" "+e.Tag] = e.Lemma
+	}
+
+	expected := map[string]string{
+		"attorney general n": "attorney general",
+		"dog n":              "dog",
+		"data n":             "datum",
+		"large a":            "large",
+		"deploy v":           "deploy",
+		"went v":             "go",
+	}
+
+	for key, lemma := range expected {
+		if lemmas[key] != lemma {
+			t.Errorf("For '%s' we've got: '%s' lemma, expected: '%s'", key, lemmas[key], lemma)
+		}
+	}
+
+	if len(d.Entries) != 19 {
+		t.Errorf("We've got %d entries, expected: 19", len(d.Entries))
+	}
+}
+
+func TestImportWordNetNotFound(t *testing.T) {
+	_, err := dicts.ImportWordNet(fstest.MapFS{}, dicts.WordNetOptions{Index: true})
+
+	if err == nil || err.Error() != "WordNet exception lists were not found!" {
+		t.Errorf("Expected not found error, got: %v", err)
+	}
+}
+
+type MorphyTestCase struct {
+	Word       string
+	Pos        string
+	Candidates []string
+}
+
+func TestMorphy(t *testing.T) {
+	testCases := []MorphyTestCase{
+		{Word: "boxes", Pos: "n", Candidates: []string{"boxe", "box"}},
+		{Word: "churches", Pos: "n", Candidates: []string{"churche", "church"}},
+		{Word: "firemen", Pos: "n", Candidates: []string{"fireman"}},
+		{Word: "boxesful", Pos: "n", Candidates: []string{"boxeful", "boxful"}},
+		{Word: "baked", Pos: "v", Candidates: []string{"bake", "bak"}},
+		{Word: "deploying", Pos: "v", Candidates: []string{"deploye", "deploy"}},
+		{Word: "larger", Pos: "a", Candidates: []string{"larg", "large"}},
+		{Word: "quickly", Pos: "r", Candidates: nil},
+	}
+
+	for _, tc := range testCases {
+		if candidates := dicts.Morphy(tc.Word, tc.Pos); !reflect.DeepEqual(candidates, tc.Candidates) {
+			t.Errorf("For the word '%s' (%s) we've got: %v, expected: %v", tc.Word, tc.Pos, candidates, tc.Candidates)
+		}
+	}
+}
+
+func TestWordNetPos(t *testing.T) {
+	for tag, pos := range map[string]string{"n": "n", "NNS": "n", "VERB": "v", "VBD": "v", "s": "a", "JJR": "a", "RB": "r", "DT": ""} {
+		if got := dicts.WordNetPos(tag); got != pos {
+			t.Errorf("For the tag '%s' we've got: '%s', expected: '%s'", tag, got, pos)
+		}
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"path/filepath"
 
 	"github.com/smileart/lemmingo"
-	"github.com/smileart/lemmingo/tagset"
 )
 
 func ExampleTagset() {
@@ -15,17 +14,24 @@ func ExampleTagset() {
 	}
 
 	lem, err := lemmingo.New(dictAbsPath, "en-GB", "freeling", true, true, false)
-	mapPos := tagset.MapPos("wordnet", "en-GB")
 
-	pos, _ := mapPos("n")
-	l, ok, e := lem.Lemma("words", pos)
+	// WordNet tags are converted to the dictionary ones on lookup, no need to map them by hand
+	l, ok, e := lem.LemmaTagged("words", "n", "wordnet")
 	fmt.Println(l, ok, e)
 
-	pos, _ = mapPos("v")
-	l, ok, e = lem.Lemma("running", pos)
+	l, ok, e = lem.LemmaTagged("running", "v", "wordnet")
+	fmt.Println(l, ok, e)
+
+	// the same instance serves the original dictionary tags and Universal Tagset ones
+	l, ok, e = lem.LemmaTagged("ran", "VBD", "freeling")
+	fmt.Println(l, ok, e)
+
+	l, ok, e = lem.Lemma("ran", "VERB")
 	fmt.Println(l, ok, e)
 
 	// Output:
 	// word true <nil>
 	// run true <nil>
+	// run true <nil>
+	// run true <nil>
 }
@@ -1,13 +1,14 @@
 module github.com/smileart/lemmingo
 
-go 1.14
+go 1.16
 
 require (
 	github.com/Jeffail/tunny v0.0.0-20190930221602-f13eb662a36a
+	github.com/klauspost/compress v1.15.9
 	github.com/mitchellh/go-homedir v1.1.0
-	github.com/otiai10/copy v1.1.1
 	github.com/tebeka/snowball v0.4.1
 	github.com/trustmaster/go-aspell v0.0.0-20140221192225-b1cc0c2c49f8
+	github.com/ulikunitz/xz v0.5.15
 	github.com/zhexuany/wordGenerator v0.0.0-20161102120352-1f13e790d534
 	golang.org/x/text v0.3.2
 )
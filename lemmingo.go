@@ -2,27 +2,28 @@
 package lemmingo
 
 import (
-	"bufio"
 	"errors"
-	"log"
-	"os"
-	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/Jeffail/tunny"
-	"github.com/mitchellh/go-homedir"
-	"github.com/otiai10/copy"
+	"github.com/smileart/lemmingo/dicts"
 	"github.com/smileart/lemmingo/tagset"
 	"github.com/tebeka/snowball"
 	"github.com/trustmaster/go-aspell"
-	"golang.org/x/text/language"
-	"golang.org/x/text/language/display"
 )
 
 // Lemmingo is the lemmatiser and its configuration
 type Lemmingo struct {
 	dict            map[string]string
+	fine            map[string]string
+	alternatives    map[string][]string
+	report          *TagsetReport
+	contractions    map[string][]Part
+	conversions     sync.Map
+	mappers         sync.Map
 	stemmerLang     string
 	spellerLang     string
 	stemmerPool     *tunny.Pool
@@ -30,6 +31,19 @@ type Lemmingo struct {
 	stemmerFallback bool
 	spellerFallback bool
 	concurrent      bool
+	morphyFallback  bool
+	strictTagset    bool
+	rules           *dicts.RuleSet
+	plain           map[string]string
+	lemmas          map[string]bool
+	phrases         map[string][]multiWord
+	header          dicts.Header
+	tagsetName      string
+	tagsetLang      string
+	target          string
+	source          *dicts.Dictionary
+	templates       *dicts.Templates
+	warnings        []*dicts.ParseError
 }
 
 type fallbackPayload struct {
@@ -45,30 +59,51 @@ type fallbackPayload struct {
 //
 // The only difference from Build method is that you don't need to figure out the language tags.
 //
+// When the dictionary header (format v2) declares its language and tagset, empty langTag and tagsetName are taken from it.
+//
 // It returns a pointer to Lemmingo struct.
-func New(dictPath string, langTag string, tagsetName string, stemmerFallback bool, spellerFallback bool, concurrent bool) (*Lemmingo, error) {
-	lang := language.Make(langTag)
-	base, _ := lang.Base()
-
-	tagsetLang := base.String()
-	spellerLang := langTag
-	stemmerLang := strings.ToLower(display.English.Languages().Name(base))
+func New(dictPath string, langTag string, tagsetName string, stemmerFallback bool, spellerFallback bool, concurrent bool, opts ...Option) (*Lemmingo, error) {
+	d, err := parseDictPath(dictPath)
+	if err != nil {
+		return &Lemmingo{}, err
+	}
 
-	return Build(dictPath, stemmerFallback, stemmerLang, spellerFallback, spellerLang, tagsetName, tagsetLang, concurrent)
+	return NewFromDict(d, langTag, tagsetName, stemmerFallback, spellerFallback, concurrent, opts...)
 }
 
 // Build creates a new instance of Lemmingo struct according to provided option values
 //
-// If dictPath is a relative path the default dictionary gets first copied into the current user's home `.lemmingo` directory, and then loaded from there,
-// otherwise it's loaded from absolute path provided
+// Optional pipeline stages (e.g. WithMorphyFallback) could be passed after the rest of the arguments.
+//
+// If dictPath has "embedded:" prefix (e.g. "embedded:en") the dictionary is loaded from the ones embedded into the module.
+// If dictPath is a relative path it's looked up in the current user's home `.lemmingo` directory (see dicts.Install),
+// falling back to the embedded dictionary with the same name (e.g. "./en.lmm"), otherwise it's loaded from absolute path provided
 //
 // If stemmer/speller fallbacks enabled, relevant language params will be passed to the binding libraries (NOTICE: the libs accept language options in different formats).
 //
-// When tagsetName provided, the Lemmingo will convert the PoS in the dictionary provided to Universal Tagset PoS and therefore all further lookups should use Universal Tagset PoS.
+// When tagsetName is tagset.Auto ("auto"), the dictionary tagset is detected by its tags (see tagset.Detect), unless the header declares one.
+//
+// When tagsetName provided, the Lemmingo will convert the PoS in the dictionary provided to Universal Tagset PoS and therefore all further lookups should use Universal Tagset PoS
+// (or Universal Dependencies v2 UPOS with WithTarget(tagset.UPOS) option).
+// Empty tagsetName/tagsetLang are taken from the dictionary header (format v2) if declared there, and the ones that contradict the header return an error.
 //
 // It returns a pointer to Lemmingo struct.
-func Build(dictPath string, stemmerFallback bool, stemmerLang string, spellerFallback bool, spellerLang string, tagsetName string, tagsetLang string, concurrent bool) (*Lemmingo, error) {
+func Build(dictPath string, stemmerFallback bool, stemmerLang string, spellerFallback bool, spellerLang string, tagsetName string, tagsetLang string, concurrent bool, opts ...Option) (*Lemmingo, error) {
+	d, err := parseDictPath(dictPath)
+	if err != nil {
+		return &Lemmingo{}, err
+	}
+
+	return BuildFromDict(d, stemmerFallback, stemmerLang, spellerFallback, spellerLang, tagsetName, tagsetLang, concurrent, opts...)
+}
 
+// BuildFromDict creates a new instance of Lemmingo struct with already parsed (or composed in memory) dictionary d.
+//
+// Use it with dicts.Parse in strict mode to fail on malformed dictionary lines instead of skipping them.
+// See Build for the rest of the arguments.
+//
+// It returns a pointer to Lemmingo struct.
+func BuildFromDict(d *dicts.Dictionary, stemmerFallback bool, stemmerLang string, spellerFallback bool, spellerLang string, tagsetName string, tagsetLang string, concurrent bool, opts ...Option) (*Lemmingo, error) {
 	var (
 		l   Lemmingo
 		err error
@@ -79,12 +114,77 @@ func Build(dictPath string, stemmerFallback bool, stemmerLang string, spellerFal
 	l.stemmerLang = stemmerLang
 	l.spellerLang = spellerLang
 	l.concurrent = concurrent
+	l.target = tagset.Universal
+
+	for _, opt := range opts {
+		opt(&l)
+	}
+
+	auto := tagsetName == tagset.Auto
+	if auto {
+		tagsetName = ""
+	}
 
-	l.dict, err = loadDict(dictPath, tagsetName, tagsetLang)
+	tagsetName, tagsetLang, err = headerTagset(d.Header, tagsetName, tagsetLang)
 	if err != nil {
 		return &l, err
 	}
 
+	var detection *tagset.Detection
+
+	// the dictionary header tagset wins, the compact dictionaries have the target tagset already
+	if auto && tagsetName == "" && len(d.Rules) == 0 {
+		if detection, err = detectTagset(d, tagsetLang); err != nil {
+			return &l, err
+		}
+
+		if detection.Best.Name != tagset.Universal && detection.Best.Name != tagset.UPOS {
+			tagsetName, tagsetLang = detection.Best.Name, detection.Best.Lang
+		}
+	}
+
+	if len(d.Rules) > 0 {
+		if tagsetName != "" {
+			return &l, errors.New("Dictionary rules can't be mapped with `" + tagsetName + "` tagset, compact the dictionary with the tagset instead!")
+		}
+
+		l.rules = dicts.NewRuleSet(d.Rules)
+	}
+
+	if l.target != tagset.Universal && l.target != tagset.UPOS {
+		return &l, errors.New("Target tagset `" + l.target + "` is not supported!")
+	}
+
+	if l.target != tagset.Universal && tagsetName == "" {
+		return &l, errors.New("Target tagset `" + l.target + "` requires the dictionary tagset!")
+	}
+
+	l.loadDict(d, tagsetName, tagsetLang)
+	l.loadContractions(d, tagsetName, tagsetLang)
+
+	if l.report != nil {
+		l.report.Detection = detection
+	}
+
+	if l.strictTagset {
+		if err = checkTagsetReport(l.report); err != nil {
+			return &l, err
+		}
+	}
+
+	l.tagsetName, l.tagsetLang = tagsetName, tagsetLang
+	l.source = d
+	l.phrases = loadPhrases(l.dict)
+	l.header = d.Header
+
+	if l.morphyFallback {
+		if l.lemmas, err = loadMorphy(l.dict, tagsetLang); err != nil {
+			return &l, err
+		}
+	}
+
+	l.warnings = d.Warnings
+
 	// SEE: https://github.com/tebeka/snowball/issues/3
 	// SEE: https://github.com/goodsign/snowball/blob/master/README.md#thread-safety
 	if stemmerFallback {
@@ -131,6 +231,10 @@ func Build(dictPath string, stemmerFallback bool, stemmerLang string, spellerFal
 //
 // If stemmerFallback was enabled it passes the original word to Snowball stemmer on dictionary lookup failure.
 //
+// Compact dictionaries (see dicts.Compact) have their rules applied to the words missing from the dictionary exceptions.
+//
+// If morphy fallback was enabled (see WithMorphyFallback) it tries WordNet detachment rules before the stemmer.
+//
 // If spellerFallback was enabled the stemming result gets passed to Aspell spell checker to correct the stemming issues if any.
 //
 // It returns lemmatised(/stemmed/spell-checked) word, and boolean flag marking if it was found in the dictionary
@@ -138,6 +242,13 @@ func Build(dictPath string, stemmerFallback bool, stemmerLang string, spellerFal
 //
 // It returns lemmatised word form from the dictionary.
 func (l *Lemmingo) Lemma(word string, pos string) (string, bool, error) {
+	return l.lemma(word, pos, func(word string) (string, bool) {
+		return l.lookup(word, strings.ToUpper(pos))
+	})
+}
+
+// lemma passes the word through the pipeline with the dictionary lookup provided (see Lemma)
+func (l *Lemmingo) lemma(word string, pos string, lookup func(word string) (string, bool)) (string, bool, error) {
 	var (
 		lmm string
 		err error
@@ -152,7 +263,12 @@ func (l *Lemmingo) Lemma(word string, pos string) (string, bool, error) {
 	}
 
 	// look for a word/PoS in the dict
-	lmm, ok = l.dict[word+" "+strings.ToUpper(pos)]
+	lmm, ok = lookup(word)
+
+	// if there's no word in the dict try WordNet morphy rules
+	if lmm == "" && l.morphyFallback {
+		lmm = l.morphy(word, pos)
+	}
 
 	// if there's no word in the dict and no stemmer - fail
 	if lmm == "" && !l.stemmerFallback {
@@ -194,76 +310,82 @@ func (l *Lemmingo) Close() {
 	defer l.stemmerPool.Close()
 }
 
-// spellCheck gets one spell-checking goroutine from the pool, checks the spelling and if the word was misspelled, returns the first correction
-func (l *Lemmingo) spellCheck(word string) string {
-	return l.spellerPool.Process(fallbackPayload{
-		Lang: l.spellerLang,
-		Word: word,
-	}).(string)
+// Header returns the metadata declared in the dictionary header (format v2).
+func (l *Lemmingo) Header() dicts.Header {
+	return l.header
 }
 
-// stem gets one stemming goroutine from the pool, gets the stemma for a word and returns it
-func (l *Lemmingo) stem(word string) string {
-	return l.stemmerPool.Process(fallbackPayload{
-		Lang: l.stemmerLang,
-		Word: word,
-	}).(string)
-}
+// Dictionary returns the loaded dictionary entries (e.g. for export, see dicts.ExportSpaCy), with Universal Tagset PoS
+// if the dictionary was mapped on loading. Frequencies, features and notes of the entries aren't kept on loading.
+// Compact dictionaries (see dicts.Compact) keep their rules and exceptions only.
+func (l *Lemmingo) Dictionary() *dicts.Dictionary {
+	d := &dicts.Dictionary{Header: l.header, Entries: make([]dicts.Entry, 0, len(l.dict)), Rules: l.source.Rules}
 
-// loadDict loads the dictionary provided through dictPath in the following format: "inflected_word<space>canonical_form<space>PoS_tag"
-//
-// On receiving relative path: copies the files from ./dicts to $HOME/.lemmingo and treats the path as relative to that directory (suggests the existence of ./dicts)
-// When tagsetName/tagsetLang provided: maps the dictionary PoS to Universal Tagset PoS
-//
-// It returns a map with keys in the following format: "<inflected_word>_<PoS>" with canonical form value
-func loadDict(dictPath string, tagsetName string, tagsetLang string) (map[string]string, error) {
-	dict := make(map[string]string)
+	if l.tagsetName != "" {
+		d.Header.Tagset = ""
+	}
 
-	if !filepath.IsAbs(dictPath) {
-		lemmingoHome, err := installDicts()
-		if err != nil {
-			return nil, err
+	for key, lemma := range l.dict {
+		i := strings.LastIndex(key, " ")
+
+		// the alternatives of the collided entries are kept in the dictionary order, so the last one stays the primary
+		for _, alternative := range l.alternatives[key] {
+			if alternative != lemma {
+				d.Entries = append(d.Entries, dicts.Entry{Form: key[:i], Lemma: alternative, Tag: key[i+1:]})
+			}
 		}
 
-		dictPath = filepath.Join(lemmingoHome, dictPath)
+		d.Entries = append(d.Entries, dicts.Entry{Form: key[:i], Lemma: lemma, Tag: key[i+1:]})
 	}
 
-	file, err := os.Open(dictPath)
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
+	sort.SliceStable(d.Entries, func(i, j int) bool {
+		a, b := d.Entries[i], d.Entries[j]
 
-	scanner := bufio.NewScanner(file)
+		if a.Form != b.Form {
+			return a.Form < b.Form
+		}
 
-	var (
-		de     []string
-		mapPos func(string) (string, bool)
-	)
+		return a.Tag < b.Tag
+	})
 
-	if tagsetName != "" {
-		mapPos = tagset.MapPos(tagsetName, tagsetLang)
-	}
+	return d
+}
 
-	for scanner.Scan() {
-		de = strings.Split(scanner.Text(), " ")
+// Warnings returns the malformed dictionary lines skipped on loading (with "file:line" diagnostics).
+func (l *Lemmingo) Warnings() []*dicts.ParseError {
+	return l.warnings
+}
 
-		if mapPos != nil {
-			uniPos, _ := mapPos(de[2])
+// lookup looks for the word/PoS in the dictionary, the word-aware UPOS entries by their plain tag mapping (e.g. "had" VERB)
+// and applies the rules of the compact dictionary to the missing words
+func (l *Lemmingo) lookup(word string, pos string) (string, bool) {
+	lmm, ok := l.dict[word+" "+pos]
 
-			// inflected    PoS    lemma
-			dict[de[0]+" "+uniPos] = de[1]
-		} else {
-			// inflected    PoS     lemma
-			dict[de[0]+" "+de[2]] = de[1]
-		}
+	if !ok && l.plain != nil {
+		lmm, ok = l.plain[word+" "+pos]
 	}
 
-	if err := scanner.Err(); err != nil {
-		return nil, err
+	if !ok && l.rules != nil {
+		lmm, ok = l.rules.Lemma(word, pos)
 	}
 
-	return dict, nil
+	return lmm, ok
+}
+
+// spellCheck gets one spell-checking goroutine from the pool, checks the spelling and if the word was misspelled, returns the first correction
+func (l *Lemmingo) spellCheck(word string) string {
+	return l.spellerPool.Process(fallbackPayload{
+		Lang: l.spellerLang,
+		Word: word,
+	}).(string)
+}
+
+// stem gets one stemming goroutine from the pool, gets the stemma for a word and returns it
+func (l *Lemmingo) stem(word string) string {
+	return l.stemmerPool.Process(fallbackPayload{
+		Lang: l.stemmerLang,
+		Word: word,
+	}).(string)
 }
 
 // loadStemmer creates a new Snowball stemmer for the language provided
@@ -325,38 +447,3 @@ func loadSpellerPool(goLimit int) *tunny.Pool {
 
 	return pool
 }
-
-// installDicts copies the default ./dicts to current user's $HOME for DEVELOPMENT convenience.
-// For production, containers and binary distributions use absolute path to the dictionary,
-// so this method woudn't be called
-func installDicts() (string, error) {
-	_, dbg := os.LookupEnv("DEBUG")
-
-	homePath, err := homedir.Dir()
-	if err != nil {
-		return "", err
-	}
-
-	_, filename, _, _ := runtime.Caller(1)
-
-	localDictsPath, err := filepath.Abs(filepath.Join(filepath.Dir(filename), "./dicts"))
-	if err != nil {
-		return "", err
-	}
-
-	lemmingoHome := filepath.Join(homePath, ".lemmingo")
-	if _, err := os.Stat(lemmingoHome); !os.IsNotExist(err) {
-		return lemmingoHome, nil
-	}
-
-	if dbg {
-		log.Printf("Default Lemmingo dictionaries are going to be installed to: %s", lemmingoHome)
-	}
-
-	err = copy.Copy(localDictsPath, lemmingoHome)
-	if err != nil {
-		return "", err
-	}
-
-	return lemmingoHome, nil
-}
@@ -1,11 +1,18 @@
 package lemmingo_test
 
 import (
+	"bytes"
+	"compress/gzip"
+	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"sync"
 	"testing"
 
 	"github.com/smileart/lemmingo"
+	"github.com/smileart/lemmingo/dicts"
+	"github.com/smileart/lemmingo/tagset"
 	"github.com/zhexuany/wordGenerator"
 )
 
@@ -15,6 +22,13 @@ type LemmaTestCase struct {
 	lemma string
 }
 
+type MorphyTestCase struct {
+	word  string
+	pos   string
+	lemma string
+	found bool
+}
+
 type StemTestCase struct {
 	word  string
 	stem  string
@@ -105,6 +119,630 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNewEmbedded(t *testing.T) {
+	lem, err := lemmingo.New("embedded:en", "en-US", "penn", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lmm, _, _ := lem.Lemma("caresses", "NOUN"); lmm != "caress" {
+		t.Errorf("For the word 'caresses' we've got: '%s' lemma, expected: 'caress'.", lmm)
+	}
+}
+
+func TestNewWrongEmbedded(t *testing.T) {
+	_, err := lemmingo.New("embedded:uk", "uk", "", false, false, false)
+
+	if err == nil {
+		t.Errorf("The method was supposed to return an error on missing embedded dictionary!")
+	}
+}
+
+func TestNewFromReader(t *testing.T) {
+	var b bytes.Buffer
+
+	w := gzip.NewWriter(&b)
+	w.Write([]byte("caresses caress NNS\n"))
+	w.Close()
+
+	lem, err := lemmingo.NewFromReader(&b, "en-US", "penn", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lmm, _, _ := lem.Lemma("caresses", "NOUN"); lmm != "caress" {
+		t.Errorf("For the word 'caresses' we've got: '%s' lemma, expected: 'caress'.", lmm)
+	}
+}
+
+func TestNewFromFS(t *testing.T) {
+	lem, err := lemmingo.NewFromFS(os.DirFS("./dicts"), "en.lmm.gz", "en-US", "", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lmm, _, _ := lem.Lemma("abandoning", "VBG"); lmm != "abandon" {
+		t.Errorf("For the word 'abandoning' we've got: '%s' lemma, expected: 'abandon'.", lmm)
+	}
+
+	if _, err := lemmingo.NewFromFS(os.DirFS("./dicts"), "uk.lmm", "uk", "", false, false, false); err == nil {
+		t.Errorf("The method was supposed to return an error on missing dictionary file!")
+	}
+}
+
+func TestNewWithMalformedLines(t *testing.T) {
+	data := "caresses caress NNS\nbroken\n\nam be VBP trailing\n"
+
+	lem, err := lemmingo.NewFromReader(strings.NewReader(data), "en-US", "", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lmm, _, _ := lem.Lemma("caresses", "NNS"); lmm != "caress" {
+		t.Errorf("For the word 'caresses' we've got: '%s' lemma, expected: 'caress'.", lmm)
+	}
+
+	if w := lem.Warnings(); len(w) != 2 || w[0].Line != 2 || w[1].Line != 4 {
+		t.Errorf("Expected warnings for lines 2 and 4, got: %v", w)
+	}
+}
+
+func TestBuildFromStrictDict(t *testing.T) {
+	_, err := dicts.Parse(strings.NewReader("caresses caress NNS\nbroken\n"), "custom.lmm", true)
+	if err == nil || err.Error() != "custom.lmm:2: expected 3 fields (form, lemma, tag), got 1 in \"broken\"" {
+		t.Errorf("Expected strict parsing error, got: %v", err)
+	}
+
+	d, _ := dicts.Parse(strings.NewReader("caresses caress NNS\n"), "custom.lmm", true)
+
+	lem, err := lemmingo.BuildFromDict(d, false, "", false, "", "penn", "en", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lmm, _, _ := lem.Lemma("caresses", "NOUN"); lmm != "caress" {
+		t.Errorf("For the word 'caresses' we've got: '%s' lemma, expected: 'caress'.", lmm)
+	}
+}
+
+func TestNewFromHeader(t *testing.T) {
+	data := "#lmm 2\n#: lang en-GB\n#: tagset penn\ncaresses\tcaress\tNNS\t12\n"
+
+	lem, err := lemmingo.NewFromReader(strings.NewReader(data), "", "", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lmm, _, _ := lem.Lemma("caresses", "NOUN"); lmm != "caress" {
+		t.Errorf("For the word 'caresses' we've got: '%s' lemma, expected: 'caress'.", lmm)
+	}
+
+	if h := lem.Header(); h.Lang != "en-GB" || h.Tagset != "penn" {
+		t.Errorf("Unexpected dictionary header: %+v", h)
+	}
+}
+
+func TestNewHeaderMismatch(t *testing.T) {
+	data := "#lmm 2\n#: lang en\n#: tagset penn\ncaresses caress NNS\n"
+
+	if _, err := lemmingo.NewFromReader(strings.NewReader(data), "en", "freeling", false, false, false); err == nil {
+		t.Errorf("The method was supposed to return an error on tagset mismatch!")
+	}
+
+	if _, err := lemmingo.NewFromReader(strings.NewReader(data), "pt-BR", "", false, false, false); err == nil {
+		t.Errorf("The method was supposed to return an error on language mismatch!")
+	}
+
+	if _, err := lemmingo.BuildFromReader(strings.NewReader(data), false, "", false, "", "penn", "en-US", false); err != nil {
+		t.Errorf("Matching regional language tag was supposed to be accepted, got: %s", err)
+	}
+}
+
+func TestLemmaWithMorphy(t *testing.T) {
+	d, err := dicts.ImportWordNet(os.DirFS("dicts/testdata/wordnet"), dicts.WordNetOptions{Index: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lem, err := lemmingo.NewFromDict(d, "", "", false, false, false, lemmingo.WithMorphyFallback())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []MorphyTestCase{
+		{word: "mice", pos: "NOUN", lemma: "mouse", found: true},
+		{word: "dogs", pos: "NOUN", lemma: "dog"},
+		{word: "boxes", pos: "NOUN", lemma: "box"},
+		{word: "baked", pos: "VERB", lemma: "bake"},
+		{word: "deploying", pos: "VERB", lemma: "deploy"},
+		{word: "larger", pos: "ADJ", lemma: "large"},
+	}
+
+	for _, tc := range testCases {
+		lmm, found, err := lem.Lemma(tc.word, tc.pos)
+
+		if err != nil || lmm != tc.lemma || found != tc.found {
+			t.Errorf("For the word '%s' we've got: '%s' lemma (found: %v, err: %v), expected: '%s' (found: %v)", tc.word, lmm, found, err, tc.lemma, tc.found)
+		}
+	}
+
+	if _, _, err := lem.Lemma("dogs", "VERB"); err == nil {
+		t.Errorf("The method was supposed to return an error for the candidate of another PoS!")
+	}
+
+	if _, err := lemmingo.NewFromReader(strings.NewReader("hunde hund NN\n"), "de", "", false, false, false, lemmingo.WithMorphyFallback()); err == nil {
+		t.Errorf("The method was supposed to return an error for non-English dictionary!")
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	data := "#lmm 2\n#: lang en\n#: tagset penn\nmice\tmouse\tNNS\t12\nran out\trun out\tVBD\n"
+
+	lem, err := lemmingo.NewFromReader(strings.NewReader(data), "", "", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+
+	if err := dicts.ExportJSONLines(&buf, lem.Dictionary(), dicts.ExportOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"form":"mice","lemma":"mouse","tag":"NOUN"}
+{"form":"ran out","lemma":"run out","tag":"VERB"}
+`
+
+	if buf.String() != expected {
+		t.Errorf("We've got:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestAddParadigm(t *testing.T) {
+	data := "#lmm 2\n#: lang en\n#: tagset penn\n" +
+		"walk\twalk\tVB\nwalked\twalk\tVBD\nwalks\twalk\tVBZ\n" +
+		"jump\tjump\tVB\njumped\tjump\tVBD\njumps\tjump\tVBZ\n" +
+		"dog\tdog\tNN\ndogs\tdog\tNNS\ncat\tcat\tNN\ncats\tcat\tNNS\n"
+
+	lem, err := lemmingo.NewFromReader(strings.NewReader(data), "", "", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := lem.AddParadigm("deploy", "verb-regular"); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := lem.AddParadigm("mouse", "noun-irregular", "NNS=mice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 2 || entries[0].Form != "mice" || entries[0].Tag != "NNS" {
+		t.Errorf("Unexpected generated entries: %+v", entries)
+	}
+
+	testCases := []LemmaTestCase{
+		{word: "deployed", pos: "VERB", lemma: "deploy"},
+		{word: "deploys", pos: "VERB", lemma: "deploy"},
+		{word: "mice", pos: "NOUN", lemma: "mouse"},
+		{word: "mouse", pos: "NOUN", lemma: "mouse"},
+	}
+
+	for _, tc := range testCases {
+		if lmm, found, _ := lem.Lemma(tc.word, tc.pos); lmm != tc.lemma || !found {
+			t.Errorf("For the word '%s' we've got: '%s' lemma (found: %v), expected: '%s'", tc.word, lmm, found, tc.lemma)
+		}
+	}
+
+	if _, err := lem.AddParadigm("good", "adj-regular"); err == nil {
+		t.Errorf("The method was supposed to return an error for unknown inflection class!")
+	}
+}
+
+func TestLemmaPhrase(t *testing.T) {
+	data := "in spite of\tin spite of\tIN\n" +
+		"ran out\trun out\tVBD\n" +
+		"ran out of\trun out of\tVBD\n" +
+		"look forward\tlook forward\tVB\n" +
+		"look forward to\tlook forward to\tVB\n" +
+		"look forward to\tlook forward to\tVBP\n" +
+		"they they PRP\nran run VBD\ntime time NN\nit it PRP\n"
+
+	lem, err := lemmingo.NewFromReader(strings.NewReader(data), "en", "", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokens := []string{"They", "ran", "out", "of", "time", "in", "spite", "of", "it", "ran"}
+	tags := []string{"PRP", "VBD", "RP", "IN", "NN", "IN", "NN", "IN", "PRP", "VBD"}
+
+	phrases, err := lem.LemmaPhrase(tokens, tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []lemmingo.Phrase{
+		{Start: 0, End: 1, Lemma: "they", Tag: "PRP", Found: true},
+		{Start: 1, End: 4, Lemma: "run out of", Tag: "VBD", Found: true},
+		{Start: 4, End: 5, Lemma: "time", Tag: "NN", Found: true},
+		{Start: 5, End: 8, Lemma: "in spite of", Tag: "IN", Found: true},
+		{Start: 8, End: 9, Lemma: "it", Tag: "PRP", Found: true},
+		{Start: 9, End: 10, Lemma: "run", Tag: "VBD", Found: true},
+	}
+
+	if len(phrases) != len(expected) {
+		t.Fatalf("We've got phrases: %+v, expected: %+v", phrases, expected)
+	}
+
+	for i, p := range phrases {
+		if p != expected[i] {
+			t.Errorf("Phrase #%d: we've got: %+v, expected: %+v", i, p, expected[i])
+		}
+	}
+
+	phrases, _ = lem.LemmaPhrase([]string{"I", "look", "forward", "to", "it"}, []string{"PRP", "VBP", "RB", "TO", "PRP"})
+	if !phrases[1].MultiWord() || phrases[1].Lemma != "look forward to" || phrases[1].Tag != "VBP" || phrases[0].Found {
+		t.Errorf("Unexpected phrases: %+v", phrases)
+	}
+
+	if _, err := lem.LemmaPhrase(tokens, tags[1:]); err == nil {
+		t.Errorf("The method was supposed to return an error on tags/tokens mismatch!")
+	}
+
+	lem, err = lemmingo.NewFromReader(strings.NewReader("New York\tNew York\tNNP\n"), "en", "", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tokens := range [][]string{{"New", "York"}, {"new", "york"}, {"NEW", "YORK"}} {
+		phrases, _ = lem.LemmaPhrase(tokens, nil)
+		if len(phrases) != 1 || phrases[0].Lemma != "New York" || !phrases[0].Found {
+			t.Errorf("We've got phrases: %+v for %v, expected: the `New York` phrase", phrases, tokens)
+		}
+	}
+}
+
+func TestCompactLemma(t *testing.T) {
+	r, err := dicts.Open("en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	d, err := dicts.Parse(r, "en.lmm", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tagsetName := range []string{"", "freeling"} {
+		full, err := lemmingo.Build("embedded:en", false, "", false, "", tagsetName, "en", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		compact, err := dicts.Compact(d, dicts.CompactOptions{Tagset: tagsetName, Lang: "en"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(compact.Entries) > len(d.Entries)/10 {
+			t.Errorf("Compact dictionary (tagset: %q) kept %d of %d entries", tagsetName, len(compact.Entries), len(d.Entries))
+		}
+
+		lem, err := lemmingo.NewFromDict(compact, "", "", false, false, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, e := range full.Dictionary().Entries {
+			// the words are lowercased on lookup, so the capitalised forms are never found in the full dictionary
+			if e.Form != strings.ToLower(e.Form) {
+				continue
+			}
+
+			expected, expectedFound, _ := full.Lemma(e.Form, e.Tag)
+
+			if lmm, found, err := lem.Lemma(e.Form, e.Tag); lmm != expected || found != expectedFound || !found || err != nil {
+				t.Errorf("For the word '%s' %s (tagset: %q) we've got: '%s' lemma (found: %v), expected: '%s' (found: %v)", e.Form, e.Tag, tagsetName, lmm, found, expected, expectedFound)
+			}
+		}
+	}
+
+	if _, err := lemmingo.NewFromReader(strings.NewReader("#lmm 2\n#: rule NNS s _\n"), "en", "penn", false, false, false); err == nil {
+		t.Errorf("The method was supposed to return an error for the rules mapped with a tagset!")
+	}
+}
+
+func TestLemmaWithUPOS(t *testing.T) {
+	lem, err := lemmingo.New("embedded:en", "en", "freeling", false, false, false, lemmingo.WithTarget(tagset.UPOS))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []LemmaTestCase{
+		{word: "was", pos: "AUX", lemma: "be"},
+		{word: "has", pos: "AUX", lemma: "have"},
+		{word: "had", pos: "AUX", lemma: "have"},
+		{word: "could", pos: "AUX", lemma: "can"},
+		{word: "running", pos: "VERB", lemma: "run"},
+		{word: "because", pos: "SCONJ", lemma: "because"},
+		{word: "and", pos: "CCONJ", lemma: "and"},
+		{word: "mice", pos: "NOUN", lemma: "mouse"},
+	}
+
+	for _, tc := range testCases {
+		if lmm, found, _ := lem.Lemma(tc.word, tc.pos); lmm != tc.lemma || !found {
+			t.Errorf("For the word '%s' we've got: '%s' lemma (found: %v), expected: '%s'", tc.word, lmm, found, tc.lemma)
+		}
+	}
+
+	// main verb "have" (e.g. "I had lunch") is tagged VERB by UD taggers, but the dictionary entries stay AUX
+	for _, word := range []string{"had", "has", "having"} {
+		if lmm, found, _ := lem.Lemma(word, "VERB"); lmm != "have" || !found {
+			t.Errorf("For the word '%s' VERB we've got: '%s' lemma (found: %v), expected: 'have'", word, lmm, found)
+		}
+	}
+
+	small, err := lemmingo.NewFromReader(strings.NewReader("had have VBD\nhas have VBZ\nran run VBD\n"), "en", "penn", false, false, false, lemmingo.WithTarget(tagset.UPOS))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var entries []string
+
+	for _, e := range small.Dictionary().Entries {
+		entries = append(entries, e.Form+" "+e.Lemma+" "+e.Tag)
+	}
+
+	if expected := []string{"had have AUX", "has have AUX", "ran run VERB"}; !reflect.DeepEqual(entries, expected) {
+		t.Errorf("We've got dictionary entries: %v, expected: %v", entries, expected)
+	}
+
+	if _, err := lemmingo.New("embedded:en", "en", "", false, false, false, lemmingo.WithTarget(tagset.UPOS)); err == nil {
+		t.Errorf("The method was supposed to return an error for UPOS target without tagset!")
+	}
+
+	if _, err := lemmingo.New("embedded:en", "en", "freeling", false, false, false, lemmingo.WithTarget("ptb")); err == nil {
+		t.Errorf("The method was supposed to return an error for unsupported target tagset!")
+	}
+}
+
+func TestNewWithRegisteredTagset(t *testing.T) {
+	if err := tagset.Register("lowercase", "en", map[string]string{"nns": "NOUN", "vbd": "VERB", "md": "AUX"}); err != nil {
+		t.Fatal(err)
+	}
+
+	data := "mice mouse nns\nran run vbd\ncould can md\n"
+
+	lem, err := lemmingo.NewFromReader(strings.NewReader(data), "en", "lowercase", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []LemmaTestCase{
+		{word: "mice", pos: "NOUN", lemma: "mouse"},
+		{word: "ran", pos: "VERB", lemma: "run"},
+		{word: "could", pos: "VERB", lemma: "can"},
+	}
+
+	for _, tc := range testCases {
+		if lmm, _, _ := lem.Lemma(tc.word, tc.pos); lmm != tc.lemma {
+			t.Errorf("For the word '%s' we've got: '%s' lemma, expected: '%s'", tc.word, lmm, tc.lemma)
+		}
+	}
+}
+
+func TestLemmaTagged(t *testing.T) {
+	lem, err := lemmingo.New("embedded:en", "en", "freeling", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		word    string
+		tag     string
+		tagset  string
+		lemma   string
+		succeed bool
+	}{
+		{word: "mice", tag: "NNS", tagset: "freeling", lemma: "mouse", succeed: true},
+		{word: "mice", tag: "NNS", tagset: "", lemma: "mouse", succeed: true},
+		{word: "mice", tag: "NN", tagset: "freeling", lemma: "mice", succeed: false},
+		{word: "mice", tag: "NOUN", tagset: tagset.Universal, lemma: "mouse", succeed: true},
+		{word: "running", tag: "v", tagset: "wordnet", lemma: "run", succeed: true},
+		{word: "better", tag: "a", tagset: "wordnet", lemma: "good", succeed: true},
+		{word: "was", tag: "AUX", tagset: tagset.UPOS, lemma: "be", succeed: true},
+		{word: "mice", tag: "NNS", tagset: "penn", lemma: "mouse", succeed: true},
+		{word: "mice", tag: "NNS", tagset: "unknown", lemma: "mice", succeed: false},
+	}
+
+	for _, tc := range testCases {
+		lmm, found, err := lem.LemmaTagged(tc.word, tc.tag, tc.tagset)
+
+		if lmm != tc.lemma || found != tc.succeed || (err == nil) != tc.succeed {
+			t.Errorf("For the word '%s' %s (%s) we've got: '%s' lemma (found: %v, err: %v), expected: '%s'", tc.word, tc.tag, tc.tagset, lmm, found, err, tc.lemma)
+		}
+	}
+
+	// the dictionary tags index is kept along with the universal one
+	if lmm, _, _ := lem.Lemma("mice", "NOUN"); lmm != "mouse" {
+		t.Errorf("For the word 'mice' we've got: '%s' lemma, expected: 'mouse'", lmm)
+	}
+
+	plain, err := lemmingo.NewFromReader(strings.NewReader("mice mouse NNS\n"), "en", "", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := plain.LemmaTagged("mice", "n", "wordnet"); err == nil {
+		t.Errorf("The method was supposed to return an error for the dictionary without tagset!")
+	}
+}
+
+func TestTagsetReport(t *testing.T) {
+	d, err := dicts.Parse(strings.NewReader("'d have VBD\n'd would MD\nran run VBD\nran run VBN\nfoo bar ZZ\n"), "custom.lmm", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lem, err := lemmingo.BuildFromDict(d, false, "", false, "", "penn", "en", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := lem.TagsetReport()
+
+	if report.Entries != 5 || report.Mapped != 4 || report.Unmapped["ZZ"] != 1 || len(report.Collisions) != 1 {
+		t.Fatalf("We've got unexpected report: %+v", report)
+	}
+
+	if c := report.Collisions[0]; c.Form != "'d" || c.Tag != "VERB" || len(c.Entries) != 2 {
+		t.Errorf("We've got unexpected collision: %+v", c)
+	}
+
+	if lemmas := lem.Lemmas("'d", "VERB"); !reflect.DeepEqual(lemmas, []string{"have", "would"}) {
+		t.Errorf("For the word ''d' we've got: %v lemmas, expected: [have would]", lemmas)
+	}
+
+	if lemmas := lem.Lemmas("ran", "VERB"); !reflect.DeepEqual(lemmas, []string{"run"}) {
+		t.Errorf("For the word 'ran' we've got: %v lemmas, expected: [run]", lemmas)
+	}
+
+	// the entries with tags unknown to the tagset aren't indexed with empty PoS
+	if _, found, _ := lem.Lemma("foo", ""); found {
+		t.Errorf("The word 'foo' with unknown tag wasn't supposed to be found!")
+	}
+
+	if _, err := lemmingo.BuildFromDict(d, false, "", false, "", "penn", "en", false, lemmingo.WithStrictTagset()); err == nil {
+		t.Errorf("The constructor was supposed to return an error for the dictionary with unmapped tags and collisions!")
+	}
+
+	plain, err := lemmingo.BuildFromDict(d, false, "", false, "", "", "", false, lemmingo.WithStrictTagset())
+	if err != nil || plain.TagsetReport() != nil {
+		t.Errorf("The dictionary without tagset wasn't supposed to have the report (err: %v)", err)
+	}
+}
+
+func TestLemmaParts(t *testing.T) {
+	lem, err := lemmingo.New("embedded:en", "en", "freeling", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		word    string
+		pos     string
+		parts   []lemmingo.Part
+		succeed bool
+	}{
+		{word: "i'dn't've", pos: "", parts: []lemmingo.Part{{"i", "PRON"}, {"would", "VERB"}, {"not", "ADV"}, {"have", "VERB"}}, succeed: true},
+		{word: "I'dn't've", pos: "PRON", parts: []lemmingo.Part{{"i", "PRON"}, {"would", "VERB"}, {"not", "ADV"}, {"have", "VERB"}}, succeed: true},
+		{word: "don't", pos: "VB+RB", parts: []lemmingo.Part{{"do", "VERB"}, {"not", "ADV"}}, succeed: true},
+		{word: "mice", pos: "NOUN", parts: []lemmingo.Part{{"mouse", "NOUN"}}, succeed: true},
+		{word: "mice", pos: "", parts: []lemmingo.Part{{"mice", ""}}, succeed: false},
+	}
+
+	for _, tc := range testCases {
+		parts, found, _ := lem.LemmaParts(tc.word, tc.pos)
+
+		if !reflect.DeepEqual(parts, tc.parts) || found != tc.succeed {
+			t.Errorf("For the word '%s' (%s) we've got: %v (found: %v), expected: %v", tc.word, tc.pos, parts, found, tc.parts)
+		}
+	}
+
+	upos, err := lemmingo.New("embedded:en", "en", "freeling", false, false, false, lemmingo.WithTarget(tagset.UPOS))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if parts, _, _ := upos.LemmaParts("i'd", "PRON"); !reflect.DeepEqual(parts, []lemmingo.Part{{"i", "PRON"}, {"'d", "AUX"}}) {
+		t.Errorf("For the word 'i'd' we've got: %v, expected: [{i PRON} {'d AUX}]", parts)
+	}
+}
+
+func TestNewWithPositionalTagset(t *testing.T) {
+	lem, err := lemmingo.NewFromReader(strings.NewReader("casas casa NCFP000\ncanta cantar VMIP3S0\nha haber VAIP3S0\n"), "es", "freeling", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]string{"casas NOUN": "casa", "canta VERB": "cantar", "ha VERB": "haber"}
+
+	for wordPos, expected := range testCases {
+		fields := strings.Fields(wordPos)
+
+		if lmm, _, _ := lem.Lemma(fields[0], fields[1]); lmm != expected {
+			t.Errorf("For the word '%s' we've got: '%s' lemma, expected: '%s'", wordPos, lmm, expected)
+		}
+	}
+
+	if report := lem.TagsetReport(); !report.Clean() {
+		t.Errorf("We've got unexpected report: %+v", report)
+	}
+}
+
+func TestLemmaFeatures(t *testing.T) {
+	lem, err := lemmingo.New("embedded:en", "en", "freeling", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		word   string
+		tag    string
+		tagset string
+		result lemmingo.Result
+	}{
+		{word: "ran", tag: "VBD", tagset: "", result: lemmingo.Result{Lemma: "run", Found: true, Tag: "VERB", Features: "Tense=Past|VerbForm=Fin"}},
+		{word: "mice", tag: "NNS", tagset: "penn", result: lemmingo.Result{Lemma: "mouse", Found: true, Tag: "NOUN", Features: "Number=Plur"}},
+		{word: "best", tag: "JJS", tagset: "freeling", result: lemmingo.Result{Lemma: "good", Found: true, Tag: "ADJ", Features: "Degree=Sup"}},
+		{word: "mice", tag: "noun", tagset: tagset.Universal, result: lemmingo.Result{Lemma: "mouse", Found: true, Tag: "NOUN", Features: "_"}},
+		{word: "running", tag: "v", tagset: "wordnet", result: lemmingo.Result{Lemma: "run", Found: true, Tag: "VERB", Features: "_"}},
+	}
+
+	for _, tc := range testCases {
+		result, err := lem.LemmaFeatures(tc.word, tc.tag, tc.tagset)
+
+		if result != tc.result || err != nil {
+			t.Errorf("For the word '%s' %s (%s) we've got: %+v (err: %v), expected: %+v", tc.word, tc.tag, tc.tagset, result, err, tc.result)
+		}
+	}
+}
+
+func TestNewWithSTTSTagset(t *testing.T) {
+	lem, err := lemmingo.NewFromReader(strings.NewReader("häuser Haus NN\nbist sein VAFIN\nging gehen VVFIN\n"), "de", "stts", false, false, false, lemmingo.WithTarget(tagset.UPOS))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]string{"häuser NOUN": "Haus", "bist AUX": "sein", "ging VERB": "gehen"}
+
+	for wordPos, expected := range testCases {
+		fields := strings.Fields(wordPos)
+
+		if lmm, _, _ := lem.Lemma(fields[0], fields[1]); lmm != expected {
+			t.Errorf("For the word '%s' we've got: '%s' lemma, expected: '%s'", wordPos, lmm, expected)
+		}
+	}
+}
+
+func TestBuildFromUniMorph(t *testing.T) {
+	d, _, err := dicts.ImportUniMorph(strings.NewReader("mouse\tmice\tN;PL\nrun\tran\tV;PST\n"), "eng", dicts.UniMorphOptions{Target: "penn", Lang: "en"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lem, err := lemmingo.NewFromDict(d, "", "", false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lmm, _, _ := lem.Lemma("mice", "NOUN"); lmm != "mouse" {
+		t.Errorf("For the word 'mice' we've got: '%s' lemma, expected: 'mouse'.", lmm)
+	}
+}
+
 func TestNewWrongTagset(t *testing.T) {
 	defer func() {
 		if r := recover(); r == nil {
@@ -123,7 +761,7 @@ func TestNewWrongTagsetLang(t *testing.T) {
 		}
 	}()
 
-	_, _ = lemmingo.New("./en.lmm", "pt-BR", "freeling", false, false, false)
+	_, _ = lemmingo.New("./en.lmm", "de-DE", "freeling", false, false, false)
 }
 
 func TestNewWrongRelativePath(t *testing.T) {
@@ -256,3 +894,24 @@ func BenchmarkStemmerSpellerConcurrentLemma(b *testing.B) {
 		lssc.Lemma("weirdest", "NONE")
 	}
 }
+
+func TestNewWithAutoTagset(t *testing.T) {
+	lem, err := lemmingo.New("./en.lmm", "en", tagset.Auto, false, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := lem.TagsetReport()
+
+	if report == nil || report.Tagset != "freeling" || report.Detection == nil || report.Detection.Confidence <= 0 {
+		t.Fatalf("We've got unexpected report: %+v", report)
+	}
+
+	if lmm, _, _ := lem.Lemma("mice", "NOUN"); lmm != "mouse" {
+		t.Errorf("For the word 'mice' we've got: '%s' lemma, expected: 'mouse'.", lmm)
+	}
+
+	if _, err := lemmingo.NewFromReader(strings.NewReader("foo bar ZZ\n"), "en", tagset.Auto, false, false, false); err == nil {
+		t.Errorf("The dictionary with unknown tags was loaded with detected tagset!")
+	}
+}
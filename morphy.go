@@ -0,0 +1,45 @@
+package lemmingo
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/smileart/lemmingo/dicts"
+	"golang.org/x/text/language"
+)
+
+// loadMorphy indexes the dictionary lemmas by WordNet syntactic category for morphy fallback (English only)
+//
+// It returns a map with keys in the following format: "<lemma> <category>"
+func loadMorphy(dict map[string]string, langTag string) (map[string]bool, error) {
+	if langTag != "" {
+		if base, _ := language.Make(langTag).Base(); base.String() != "en" {
+			return nil, errors.New("Morphy fallback is only available for English!")
+		}
+	}
+
+	lemmas := make(map[string]bool)
+
+	for key, lemma := range dict {
+		pos := dicts.WordNetPos(key[strings.LastIndex(key, " ")+1:])
+
+		if pos != "" {
+			lemmas[lemma+" "+pos] = true
+		}
+	}
+
+	return lemmas, nil
+}
+
+// morphy applies WordNet morphy detachment rules to the word and returns the first candidate known as a lemma
+func (l *Lemmingo) morphy(word string, pos string) string {
+	pos = dicts.WordNetPos(pos)
+
+	for _, candidate := range dicts.Morphy(word, pos) {
+		if l.lemmas[candidate+" "+pos] {
+			return candidate
+		}
+	}
+
+	return ""
+}
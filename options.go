@@ -0,0 +1,35 @@
+package lemmingo
+
+// Option configures optional stages of the Lemmingo pipeline, pass them after the constructor arguments
+type Option func(l *Lemmingo)
+
+// WithMorphyFallback enables WordNet morphy detachment rules between the dictionary lookup and the stemmer (English only).
+//
+// Base form candidates (e.g. "box" for "boxes") are accepted only if the dictionary has them as lemmas of the same
+// syntactic category (noun, verb, adjective, adverb), so it works best with WordNet dictionary (see dicts.ImportWordNet).
+func WithMorphyFallback() Option {
+	return func(l *Lemmingo) {
+		l.morphyFallback = true
+	}
+}
+
+// WithTarget sets the target tagset of the dictionary PoS mapping: tagset.Universal (by default) or tagset.UPOS,
+// e.g. to look up the words with Universal Dependencies v2 UPOS tags (AUX, PROPN, SCONJ, etc.) emitted by modern taggers.
+//
+// UPOS mapping is word-aware (see tagset.MapTo), so the forms of "be" and "have" are looked up with AUX,
+// as well as with the plain tag mapping (e.g. VERB for main verb "have" of "I had lunch") if no other entry has it.
+func WithTarget(target string) Option {
+	return func(l *Lemmingo) {
+		l.target = target
+	}
+}
+
+// WithStrictTagset makes the constructors fail when the dictionary tags don't fit the tagset mapping: some tags are unknown
+// to the tagset or the entries with different tags and lemmas collide on mapping (see Lemmingo.TagsetReport).
+//
+// Without it the entries with unknown tags are skipped and the colliding ones are kept as alternatives (see Lemmingo.Lemmas).
+func WithStrictTagset() Option {
+	return func(l *Lemmingo) {
+		l.strictTagset = true
+	}
+}
@@ -0,0 +1,62 @@
+package lemmingo
+
+import (
+	"github.com/smileart/lemmingo/dicts"
+	"github.com/smileart/lemmingo/tagset"
+)
+
+// AddParadigm generates all the forms of the lemma by the paradigm template of the inflection class and adds them
+// to the dictionary, e.g. AddParadigm("deploy", "verb-regular") or AddParadigm("mouse", "noun-irregular", "NNS=mice").
+//
+// The templates are inferred from the loaded dictionary on the first call (see dicts.InferTemplates), so the dictionary
+// tagset should be known (from the header or tagsetName), and the overrides use the dictionary tags (e.g. Penn Treebank ones).
+//
+// WARNING: AddParadigm isn't safe to call concurrently with the lookups.
+//
+// It returns the generated entries (with the dictionary tags).
+func (l *Lemmingo) AddParadigm(lemma string, class string, overrides ...string) ([]dicts.Entry, error) {
+	if l.templates == nil {
+		templates, err := dicts.InferTemplates(l.source, dicts.ParadigmOptions{Tagset: l.tagsetName, Lang: l.tagsetLang})
+		if err != nil {
+			return nil, err
+		}
+
+		l.templates = templates
+	}
+
+	entries, err := l.templates.Generate(lemma, class, overrides...)
+	if err != nil {
+		return nil, err
+	}
+
+	var mapPos func(string, string) (string, bool)
+
+	if l.tagsetName != "" {
+		mapPos = tagset.MapTo(l.target, l.tagsetName, l.tagsetLang)
+	}
+
+	for _, e := range entries {
+		tag := e.Tag
+
+		if l.fine != nil {
+			l.fine[e.Form+" "+e.Tag] = e.Lemma
+		}
+
+		if mapPos != nil {
+			var ok bool
+
+			// the tags unknown to the tagset are skipped as on loading (see Lemmingo.TagsetReport)
+			if tag, ok = mapPos(e.Form, tag); !ok {
+				continue
+			}
+		}
+
+		l.dict[e.Form+" "+tag] = e.Lemma
+
+		if l.morphyFallback {
+			l.lemmas[e.Lemma+" "+dicts.WordNetPos(tag)] = true
+		}
+	}
+
+	return entries, nil
+}
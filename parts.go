@@ -0,0 +1,100 @@
+package lemmingo
+
+import (
+	"strings"
+
+	"github.com/smileart/lemmingo/dicts"
+	"github.com/smileart/lemmingo/tagset"
+)
+
+// Part is a part of the contraction lemma with its tag, e.g. {"would", "VERB"} of "i'dn't've"
+type Part struct {
+	Lemma string
+	Tag   string // target tag of the part (or the dictionary one if the dictionary wasn't mapped), "/"-separated alternatives if ambiguous
+}
+
+// LemmaParts passes the word through the same pipeline as Lemma, but returns the contraction lemmas of the dictionary
+// split into the parts aligned with their tags, e.g. "i", "would", "not", "have" with "PRON", "VERB", "ADV", "VERB"
+// for "i'dn't've" (FreeLing "i+would+not+have PRP+MD+RB+VBP" entry), so the contraction could be treated as separate tokens.
+//
+// The PoS is either the mapped one (e.g. "PRON" for FreeLing "PRP+MD+RB+VBP") or the original composite tag of the dictionary,
+// empty PoS matches the first contraction of the word. The rest of the words get a single part with the lemma and PoS provided.
+//
+// It returns the parts, boolean flag marking if the word was found in the dictionary
+// (and the error if stemmer was disabled and the word wasn't found).
+func (l *Lemmingo) LemmaParts(word string, pos string) ([]Part, bool, error) {
+	key := strings.ToLower(word)
+
+	if pos != "" {
+		key += " " + strings.ToUpper(pos)
+	}
+
+	if parts, ok := l.contractions[key]; ok {
+		return append([]Part(nil), parts...), true, nil
+	}
+
+	lmm, ok, err := l.Lemma(word, pos)
+
+	return []Part{{Lemma: lmm, Tag: strings.ToUpper(pos)}}, ok, err
+}
+
+// loadContractions indexes the parts of the contraction entries (the ones with composite tags) by form/mapped tag,
+// form/original tag and form, the first entry of the form wins for the latter
+func (l *Lemmingo) loadContractions(d *dicts.Dictionary, tagsetName string, tagsetLang string) {
+	var (
+		mapPos       func(string, string) (string, bool)
+		mapComposite func([]string, string) ([]string, bool)
+	)
+
+	if tagsetName != "" {
+		mapPos = tagset.MapTo(l.target, tagsetName, tagsetLang)
+		mapComposite = tagset.MapComposite(l.target, tagsetName, tagsetLang)
+	}
+
+	for _, de := range d.Entries {
+		if !tagset.Composite(de.Tag) {
+			continue
+		}
+
+		lemmas := strings.Split(de.Lemma, tagset.PartSeparator)
+		tags := tagset.SplitComposite(de.Tag)
+
+		if mapComposite != nil {
+			var ok bool
+
+			if tags, ok = mapComposite(lemmas, de.Tag); !ok {
+				continue
+			}
+		}
+
+		if len(lemmas) != len(tags) {
+			continue
+		}
+
+		if l.contractions == nil {
+			l.contractions = make(map[string][]Part)
+		}
+
+		parts := make([]Part, len(lemmas))
+
+		for i := range lemmas {
+			parts[i] = Part{Lemma: lemmas[i], Tag: tags[i]}
+		}
+
+		keys := []string{de.Form + " " + de.Tag}
+
+		if mapPos != nil {
+			if uniPos, ok := mapPos(de.Form, de.Tag); ok {
+				keys = append(keys, de.Form+" "+uniPos)
+			}
+		}
+
+		for _, key := range keys {
+			l.contractions[key] = parts
+		}
+
+		if _, ok := l.contractions[de.Form]; !ok {
+			l.contractions[de.Form] = parts
+		}
+	}
+}
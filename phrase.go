@@ -0,0 +1,155 @@
+package lemmingo
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// Phrase is a lemmatised span of tokens: either a multi-word expression from the dictionary or a single token
+type Phrase struct {
+	Start int    // index of the first token of the span
+	End   int    // index after the last token of the span
+	Lemma string // lemma of the whole span
+	Tag   string // PoS of the multi-word expression from the dictionary, or the tag of the single token
+	Found bool   // whether the lemma was found in the dictionary
+}
+
+// MultiWord tells if the phrase spans more than one token.
+func (p Phrase) MultiWord() bool {
+	return p.End-p.Start > 1
+}
+
+// multiWord is a multi-word expression entry of the dictionary
+type multiWord struct {
+	tokens []string
+	lemma  string
+	tag    string
+}
+
+// LemmaPhrase lemmatises a sequence of tokens with their PoS tags (tags could be nil), greedily matching the longest
+// multi-word expressions from the dictionary (e.g. "ran out" -> "run out") and passing the rest of the tokens through Lemma.
+//
+// Multi-word expressions are matched by words only, when the same expression has several tags in the dictionary
+// the one matching any of the covered tokens' tags is chosen.
+//
+// It returns phrases covering all the tokens in order (and the error if the number of tags doesn't match the tokens).
+func (l *Lemmingo) LemmaPhrase(tokens []string, tags []string) ([]Phrase, error) {
+	if tags != nil && len(tags) != len(tokens) {
+		return nil, errors.New("Number of tags doesn't match the number of tokens!")
+	}
+
+	var phrases []Phrase
+
+	for i := 0; i < len(tokens); {
+		if mw, ok := l.matchMultiWord(tokens[i:], tagsAt(tags, i)); ok {
+			phrases = append(phrases, Phrase{Start: i, End: i + len(mw.tokens), Lemma: mw.lemma, Tag: mw.tag, Found: true})
+			i += len(mw.tokens)
+
+			continue
+		}
+
+		var tag string
+		if tags != nil {
+			tag = tags[i]
+		}
+
+		lmm, ok, _ := l.Lemma(tokens[i], tag)
+
+		phrases = append(phrases, Phrase{Start: i, End: i + 1, Lemma: lmm, Tag: tag, Found: ok})
+		i++
+	}
+
+	return phrases, nil
+}
+
+// matchMultiWord finds the longest multi-word expression at the beginning of tokens
+func (l *Lemmingo) matchMultiWord(tokens []string, tags []string) (multiWord, bool) {
+	var (
+		best  multiWord
+		found bool
+	)
+
+	for _, mw := range l.phrases[strings.ToLower(tokens[0])] {
+		if found && len(mw.tokens) < len(best.tokens) {
+			break
+		}
+
+		if !mw.matches(tokens) {
+			continue
+		}
+
+		if !found {
+			best, found = mw, true
+		}
+
+		if len(tags) >= len(mw.tokens) && containsTag(tags[:len(mw.tokens)], mw.tag) {
+			return mw, true
+		}
+	}
+
+	return best, found
+}
+
+// matches checks if tokens start with the multi-word expression (case insensitive)
+func (mw multiWord) matches(tokens []string) bool {
+	if len(tokens) < len(mw.tokens) {
+		return false
+	}
+
+	for i, token := range mw.tokens {
+		if strings.ToLower(tokens[i]) != token {
+			return false
+		}
+	}
+
+	return true
+}
+
+// loadPhrases indexes multi-word expressions of the dictionary by their lowercased first word, longest expressions first
+func loadPhrases(dict map[string]string) map[string][]multiWord {
+	phrases := make(map[string][]multiWord)
+
+	for key, lemma := range dict {
+		sep := strings.LastIndex(key, " ")
+		tokens := strings.Fields(strings.ToLower(key[:sep]))
+
+		if len(tokens) < 2 {
+			continue
+		}
+
+		phrases[tokens[0]] = append(phrases[tokens[0]], multiWord{tokens: tokens, lemma: lemma, tag: key[sep+1:]})
+	}
+
+	for _, mws := range phrases {
+		sort.Slice(mws, func(i, j int) bool {
+			if len(mws[i].tokens) != len(mws[j].tokens) {
+				return len(mws[i].tokens) > len(mws[j].tokens)
+			}
+
+			return strings.Join(mws[i].tokens, " ")+" "+mws[i].tag < strings.Join(mws[j].tokens, " ")+" "+mws[j].tag
+		})
+	}
+
+	return phrases
+}
+
+// tagsAt returns the tags starting from the index i (or nil when there are no tags)
+func tagsAt(tags []string, i int) []string {
+	if tags == nil {
+		return nil
+	}
+
+	return tags[i:]
+}
+
+// containsTag checks if any of the tags is equal to the tag (case insensitive)
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+
+	return false
+}
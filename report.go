@@ -0,0 +1,219 @@
+package lemmingo
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/smileart/lemmingo/dicts"
+	"github.com/smileart/lemmingo/tagset"
+)
+
+// TagsetReport is the coverage of the dictionary tags by the tagset mapping and the entries collided on mapping,
+// collected on loading the dictionary with a tagset (see Lemmingo.TagsetReport)
+type TagsetReport struct {
+	Tagset     string            // dictionary tagset name, e.g. "freeling"
+	Lang       string            // language of the tagset
+	Target     string            // target tagset of the mapping (tagset.Universal or tagset.UPOS)
+	Entries    int               // number of the dictionary entries
+	Mapped     int               // number of the entries with tags known to the tagset
+	Unmapped   map[string]int    // tags unknown to the tagset with the number of their entries (skipped on loading)
+	Collisions []Collision       // form/mapped tag collisions in the dictionary order
+	Detection  *tagset.Detection // the tagset detection when the tagset name was tagset.Auto
+}
+
+// Collision is the form/mapped tag shared by the dictionary entries with different original tags and lemmas,
+// e.g. "'d" VERB of "'d have VBD" and "'d would MD"
+type Collision struct {
+	Form    string
+	Tag     string        // mapped tag
+	Lemmas  []string      // distinct lemmas in the dictionary order
+	Entries []dicts.Entry // the colliding entries, the last one of every original tag
+}
+
+// Coverage returns the share of the dictionary entries with tags known to the tagset.
+func (r *TagsetReport) Coverage() float64 {
+	if r.Entries == 0 {
+		return 1
+	}
+
+	return float64(r.Mapped) / float64(r.Entries)
+}
+
+// Clean tells if all the dictionary tags are known to the tagset and no entries collided on mapping.
+func (r *TagsetReport) Clean() bool {
+	return len(r.Unmapped) == 0 && len(r.Collisions) == 0
+}
+
+// TagsetReport returns the tagset coverage and collisions report of the dictionary loaded with a tagset
+// (nil if the dictionary wasn't mapped).
+func (l *Lemmingo) TagsetReport() *TagsetReport {
+	return l.report
+}
+
+// Lemmas returns all the dictionary lemmas of the word/PoS: the alternatives of the entries collided on the tagset mapping
+// (e.g. "have" and "would" for "'d" VERB, while Lemma returns the one of the last entry), or the only one found.
+//
+// It returns nil if the word/PoS wasn't found in the dictionary (no fallbacks are applied).
+func (l *Lemmingo) Lemmas(word string, pos string) []string {
+	word, pos = strings.ToLower(word), strings.ToUpper(pos)
+
+	if alternatives, ok := l.alternatives[word+" "+pos]; ok {
+		return append([]string(nil), alternatives...)
+	}
+
+	if lmm, ok := l.lookup(word, pos); ok {
+		return []string{lmm}
+	}
+
+	return nil
+}
+
+// loadDict indexes the dictionary entries by inflected form and PoS, when the tagset provided the entries are mapped
+// to the target tagset (the original tags are indexed as well) and the coverage/collisions report is collected
+func (l *Lemmingo) loadDict(d *dicts.Dictionary, tagsetName string, tagsetLang string) {
+	l.dict = make(map[string]string, len(d.Entries))
+
+	if tagsetName == "" {
+		for _, de := range d.Entries {
+			// inflected    PoS     lemma
+			l.dict[de.Form+" "+de.Tag] = de.Lemma
+		}
+
+		return
+	}
+
+	mapPos := tagset.MapTo(l.target, tagsetName, tagsetLang)
+
+	l.fine = make(map[string]string, len(d.Entries))
+	l.report = &TagsetReport{Tagset: tagsetName, Lang: tagsetLang, Target: l.target, Entries: len(d.Entries), Unmapped: make(map[string]int)}
+
+	keys := make([]string, len(d.Entries))
+	repeated := make(map[string]bool)
+
+	for i, de := range d.Entries {
+		l.fine[de.Form+" "+de.Tag] = de.Lemma
+
+		uniPos, ok := mapPos(de.Form, de.Tag)
+		if !ok {
+			l.report.Unmapped[de.Tag]++
+			continue
+		}
+
+		keys[i] = de.Form + " " + uniPos
+		l.report.Mapped++
+
+		if _, ok := l.dict[keys[i]]; ok {
+			repeated[keys[i]] = true
+		}
+
+		// inflected    PoS    lemma
+		l.dict[keys[i]] = de.Lemma
+
+		// word-aware UPOS (e.g. AUX of "had") gets the lookup fallback of the plain tag mapping (VERB of "I had lunch")
+		if plainPos, _ := mapPos("", de.Tag); plainPos != uniPos {
+			if l.plain == nil {
+				l.plain = make(map[string]string)
+			}
+
+			l.plain[de.Form+" "+plainPos] = de.Lemma
+		}
+	}
+
+	if len(repeated) == 0 {
+		return
+	}
+
+	var order []string
+
+	groups := make(map[string][]dicts.Entry, len(repeated))
+
+	for i, de := range d.Entries {
+		if !repeated[keys[i]] {
+			continue
+		}
+
+		if groups[keys[i]] == nil {
+			order = append(order, keys[i])
+		}
+
+		groups[keys[i]] = append(groups[keys[i]], de)
+	}
+
+	l.alternatives = make(map[string][]string)
+
+	for _, key := range order {
+		entries := lastByTag(groups[key])
+		lemmas := distinct(entries)
+
+		// the same tag with several lemmas is the dictionary ambiguity rather than the mapping collision (see dicts.Lint)
+		if len(lemmas) < 2 {
+			continue
+		}
+
+		l.alternatives[key] = lemmas
+		l.report.Collisions = append(l.report.Collisions, Collision{Form: entries[0].Form, Tag: key[len(entries[0].Form)+1:], Lemmas: lemmas, Entries: entries})
+	}
+}
+
+// detectTagset detects the dictionary tagset by its tags inventory (see tagset.Detect)
+func detectTagset(d *dicts.Dictionary, tagsetLang string) (*tagset.Detection, error) {
+	tags := make([]string, 0, len(d.Entries))
+
+	for _, de := range d.Entries {
+		tags = append(tags, de.Tag)
+	}
+
+	detection := tagset.Detect(tags, tagsetLang)
+
+	if detection.Best.Coverage == 0 {
+		return &detection, errors.New("Dictionary tagset can't be detected, none of the registered tagsets knows its tags!")
+	}
+
+	return &detection, nil
+}
+
+// checkTagsetReport returns an error if the dictionary tags don't fit the tagset mapping (see WithStrictTagset)
+func checkTagsetReport(r *TagsetReport) error {
+	if r == nil || r.Clean() {
+		return nil
+	}
+
+	return errors.New("Dictionary tags don't fit `" + r.Tagset + "_" + r.Lang + "` tagset mapping: " +
+		strconv.Itoa(len(r.Unmapped)) + " unmapped tags, " + strconv.Itoa(len(r.Collisions)) + " collisions!")
+}
+
+// lastByTag returns the last entry of every original tag (the one kept in the original tags index) in the tags order
+func lastByTag(entries []dicts.Entry) []dicts.Entry {
+	var last []dicts.Entry
+
+	index := make(map[string]int)
+
+	for _, e := range entries {
+		if i, ok := index[e.Tag]; ok {
+			last[i] = e
+			continue
+		}
+
+		index[e.Tag] = len(last)
+		last = append(last, e)
+	}
+
+	return last
+}
+
+// distinct returns the distinct lemmas of the entries in the entries order
+func distinct(entries []dicts.Entry) []string {
+	var lemmas []string
+
+	seen := make(map[string]bool)
+
+	for _, e := range entries {
+		if !seen[e.Lemma] {
+			seen[e.Lemma] = true
+			lemmas = append(lemmas, e.Lemma)
+		}
+	}
+
+	return lemmas
+}
@@ -0,0 +1,194 @@
+package lemmingo
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/smileart/lemmingo/dicts"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+// NewFromReader creates a new instance of Lemmingo struct with dictionary read from r (e.g. object storage response body).
+//
+// The dictionary data could be gzip/zstd/xz compressed, the compression is detected from the magic bytes.
+// See New for the rest of the arguments.
+//
+// It returns a pointer to Lemmingo struct.
+func NewFromReader(r io.Reader, langTag string, tagsetName string, stemmerFallback bool, spellerFallback bool, concurrent bool, opts ...Option) (*Lemmingo, error) {
+	d, err := parseDictReader(r, "")
+	if err != nil {
+		return &Lemmingo{}, err
+	}
+
+	return NewFromDict(d, langTag, tagsetName, stemmerFallback, spellerFallback, concurrent, opts...)
+}
+
+// NewFromFS creates a new instance of Lemmingo struct with dictionary file name from fsys (e.g. embed.FS or os.DirFS).
+//
+// The dictionary file could be gzip/zstd/xz compressed, the compression is detected from the magic bytes.
+// See New for the rest of the arguments.
+//
+// It returns a pointer to Lemmingo struct.
+func NewFromFS(fsys fs.FS, name string, langTag string, tagsetName string, stemmerFallback bool, spellerFallback bool, concurrent bool, opts ...Option) (*Lemmingo, error) {
+	d, err := parseDictFS(fsys, name)
+	if err != nil {
+		return &Lemmingo{}, err
+	}
+
+	return NewFromDict(d, langTag, tagsetName, stemmerFallback, spellerFallback, concurrent, opts...)
+}
+
+// NewFromDict creates a new instance of Lemmingo struct with already parsed (or composed in memory) dictionary d.
+//
+// See New for the rest of the arguments.
+//
+// It returns a pointer to Lemmingo struct.
+func NewFromDict(d *dicts.Dictionary, langTag string, tagsetName string, stemmerFallback bool, spellerFallback bool, concurrent bool, opts ...Option) (*Lemmingo, error) {
+	if langTag == "" {
+		langTag = d.Header.Lang
+	}
+
+	stemmerLang, spellerLang, tagsetLang := languages(langTag)
+
+	return BuildFromDict(d, stemmerFallback, stemmerLang, spellerFallback, spellerLang, tagsetName, tagsetLang, concurrent, opts...)
+}
+
+// BuildFromReader creates a new instance of Lemmingo struct with dictionary read from r according to provided option values.
+//
+// The dictionary data could be gzip/zstd/xz compressed, the compression is detected from the magic bytes.
+// See Build for the rest of the arguments.
+//
+// It returns a pointer to Lemmingo struct.
+func BuildFromReader(r io.Reader, stemmerFallback bool, stemmerLang string, spellerFallback bool, spellerLang string, tagsetName string, tagsetLang string, concurrent bool, opts ...Option) (*Lemmingo, error) {
+	d, err := parseDictReader(r, "")
+	if err != nil {
+		return &Lemmingo{}, err
+	}
+
+	return BuildFromDict(d, stemmerFallback, stemmerLang, spellerFallback, spellerLang, tagsetName, tagsetLang, concurrent, opts...)
+}
+
+// BuildFromFS creates a new instance of Lemmingo struct with dictionary file name from fsys according to provided option values.
+//
+// The dictionary file could be gzip/zstd/xz compressed, the compression is detected from the magic bytes.
+// See Build for the rest of the arguments.
+//
+// It returns a pointer to Lemmingo struct.
+func BuildFromFS(fsys fs.FS, name string, stemmerFallback bool, stemmerLang string, spellerFallback bool, spellerLang string, tagsetName string, tagsetLang string, concurrent bool, opts ...Option) (*Lemmingo, error) {
+	d, err := parseDictFS(fsys, name)
+	if err != nil {
+		return &Lemmingo{}, err
+	}
+
+	return BuildFromDict(d, stemmerFallback, stemmerLang, spellerFallback, spellerLang, tagsetName, tagsetLang, concurrent, opts...)
+}
+
+// parseDictPath opens the dictionary by dictPath (see openDict) and parses it in lenient mode
+func parseDictPath(dictPath string) (*dicts.Dictionary, error) {
+	dict, err := openDict(dictPath)
+	if err != nil {
+		return nil, err
+	}
+	defer dict.Close()
+
+	return dicts.Parse(dict, dictPath, false)
+}
+
+// parseDictReader decompresses the dictionary data read from r and parses it in lenient mode
+func parseDictReader(r io.Reader, name string) (*dicts.Dictionary, error) {
+	dict, err := dicts.Decompress(r)
+	if err != nil {
+		return nil, err
+	}
+	defer dict.Close()
+
+	return dicts.Parse(dict, name, false)
+}
+
+// parseDictFS opens the dictionary file name from fsys and parses it in lenient mode
+func parseDictFS(fsys fs.FS, name string) (*dicts.Dictionary, error) {
+	dict, err := dicts.OpenFS(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	defer dict.Close()
+
+	return dicts.Parse(dict, name, false)
+}
+
+// headerTagset fills empty tagsetName/tagsetLang from the dictionary header and checks the provided ones against it
+func headerTagset(header dicts.Header, tagsetName string, tagsetLang string) (string, string, error) {
+	if header.Tagset != "" {
+		if tagsetName == "" {
+			tagsetName = header.Tagset
+		} else if tagsetName != header.Tagset {
+			return "", "", errors.New("Tagset `" + tagsetName + "` doesn't match the dictionary tagset `" + header.Tagset + "`!")
+		}
+	}
+
+	if header.Lang != "" {
+		_, _, headerLang := languages(header.Lang)
+		_, _, baseLang := languages(tagsetLang)
+
+		if baseLang == "und" {
+			tagsetLang = headerLang
+		} else if baseLang != headerLang {
+			return "", "", errors.New("Language `" + tagsetLang + "` doesn't match the dictionary language `" + headerLang + "`!")
+		}
+	}
+
+	return tagsetName, tagsetLang, nil
+}
+
+// languages generates stemmer, speller and tagset language arguments from langTag (BCP 47)
+func languages(langTag string) (string, string, string) {
+	lang := language.Make(langTag)
+	base, _ := lang.Base()
+
+	stemmerLang := strings.ToLower(display.English.Languages().Name(base))
+	spellerLang := langTag
+	tagsetLang := base.String()
+
+	return stemmerLang, spellerLang, tagsetLang
+}
+
+// openDict opens the dictionary by dictPath, which could be an embedded dictionary name, absolute path,
+// or a path relative to $HOME/.lemmingo (with fallback to the embedded dictionary of the same name).
+//
+// Path-based dictionaries are opened through the fs.FS of their directory, so they're decompressed the same way.
+func openDict(dictPath string) (io.ReadCloser, error) {
+	if dicts.IsEmbedded(dictPath) {
+		return dicts.Open(dictPath)
+	}
+
+	if filepath.IsAbs(dictPath) {
+		return openDictFile(dictPath)
+	}
+
+	lemmingoHome, err := dicts.Home()
+	if err != nil {
+		return nil, err
+	}
+
+	dict, err := openDictFile(filepath.Join(lemmingoHome, dictPath))
+	if !os.IsNotExist(err) {
+		return dict, err
+	}
+
+	name := strings.TrimSuffix(filepath.Base(dictPath), ".lmm")
+	if dict, embErr := dicts.Open(name); embErr == nil {
+		return dict, nil
+	}
+
+	return nil, err
+}
+
+// openDictFile opens the dictionary file by absolute path
+func openDictFile(dictPath string) (io.ReadCloser, error) {
+	return dicts.OpenFS(os.DirFS(filepath.Dir(dictPath)), filepath.Base(dictPath))
+}
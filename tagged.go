@@ -0,0 +1,140 @@
+package lemmingo
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/smileart/lemmingo/tagset"
+)
+
+// tagCandidates are the tags to look the word up with, either in the original dictionary tagset or in the target one
+type tagCandidates struct {
+	tags []string
+	fine bool
+}
+
+// LemmaTagged passes the word through the same pipeline as Lemma, but with the tag of any tagset:
+// the dictionary one (e.g. "penn" for Penn Treebank tags), the target one (tagset.Universal or tagset.UPOS)
+// or another registered one (e.g. "wordnet"), so a single instance serves the callers using different tagsets.
+//
+// The dictionary keeps its original tags along with the mapped ones, the tags of the other tagsets are converted
+// to the dictionary ones (see tagset.Convert) and the first candidate found wins. Empty tagsetName means the dictionary tagset.
+//
+// It returns lemmatised(/stemmed/spell-checked) word, and boolean flag marking if it was found in the dictionary
+// (and the error if the tagset can't be converted, or the stemmer was disabled and the word wasn't found).
+func (l *Lemmingo) LemmaTagged(word string, tag string, tagsetName string) (string, bool, error) {
+	candidates, err := l.tagCandidates(tag, tagsetName)
+	if err != nil {
+		return strings.ToLower(word), false, err
+	}
+
+	return l.lemma(word, tag, func(word string) (string, bool) {
+		for _, t := range candidates.tags {
+			if lmm, ok := l.lookupTagged(word, t, candidates.fine); ok {
+				return lmm, true
+			}
+		}
+
+		return "", false
+	})
+}
+
+// lookupTagged looks for the word/PoS in the original dictionary tags index (fine) or the mapped one
+func (l *Lemmingo) lookupTagged(word string, tag string, fine bool) (string, bool) {
+	if fine && l.fine != nil {
+		lmm, ok := l.fine[word+" "+tag]
+
+		return lmm, ok
+	}
+
+	return l.lookup(word, tag)
+}
+
+// tagCandidates converts the tag of the tagset to the dictionary or target tagset, the conversions are cached
+func (l *Lemmingo) tagCandidates(tag string, tagsetName string) (tagCandidates, error) {
+	switch {
+	case tagsetName == "" || tagsetName == l.tagsetName:
+		return tagCandidates{tags: []string{tag}, fine: true}, nil
+	case l.tagsetName == "":
+		return tagCandidates{}, errors.New("Dictionary tagset is unknown, tags of `" + tagsetName + "` tagset can't be converted!")
+	case tagsetName == l.target:
+		return tagCandidates{tags: []string{strings.ToUpper(tag)}}, nil
+	}
+
+	key := tagsetName + " " + tag
+
+	if cached, ok := l.conversions.Load(key); ok {
+		return cached.(tagCandidates), nil
+	}
+
+	var candidates tagCandidates
+
+	switch tagsetName {
+	case tagset.Universal, tagset.UPOS:
+		candidates.tags, _ = tagset.Convert(strings.ToUpper(tag), tagsetName, l.target, l.tagsetLang)
+	default:
+		if !tagset.Registered(tagsetName, l.tagsetLang) {
+			return tagCandidates{}, errors.New("Tagset mapping for `" + tagsetName + "_" + l.tagsetLang + "` was not found!")
+		}
+
+		candidates.tags, _ = tagset.Convert(tag, tagsetName, l.tagsetName, l.tagsetLang)
+		candidates.fine = true
+	}
+
+	l.conversions.Store(key, candidates)
+
+	return candidates, nil
+}
+
+// Result is the lemmatisation result carrying the morphological features of the tag
+type Result struct {
+	Lemma    string
+	Found    bool   // whether the lemma was found in the dictionary
+	Tag      string // the tag converted to the target tagset (tagset.Universal or tagset.UPOS), as is if the dictionary wasn't mapped
+	Features string // UD features of the tag as FEATS column (see tagset.Features), "_" if there're none or they're unknown
+}
+
+// LemmaFeatures passes the word through the same pipeline as LemmaTagged and returns the result with the target tag
+// and the UD features encoded by the tag, e.g. "run", "VERB", "Tense=Past|VerbForm=Fin" for "ran" with Penn "VBD".
+//
+// Empty tagsetName means the dictionary tagset, Universal Tagset/UPOS tags encode no features.
+//
+// It returns the result (and the error if the tagset can't be converted, or the stemmer was disabled and the word wasn't found).
+func (l *Lemmingo) LemmaFeatures(word string, tag string, tagsetName string) (Result, error) {
+	lmm, found, err := l.LemmaTagged(word, tag, tagsetName)
+	result := Result{Lemma: lmm, Found: found, Tag: tag, Features: "_"}
+
+	if tagsetName == "" {
+		tagsetName = l.tagsetName
+	}
+
+	switch {
+	case tagsetName == "":
+	case tagsetName == tagset.Universal || tagsetName == tagset.UPOS:
+		result.Tag = strings.ToUpper(tag)
+
+		if candidates, _ := tagset.Convert(result.Tag, tagsetName, l.target, l.tagsetLang); len(candidates) > 0 && !containsTag(candidates, result.Tag) {
+			result.Tag = candidates[0]
+		}
+	case tagset.Registered(tagsetName, l.tagsetLang):
+		if pos, ok := l.mapper(tagsetName)(word, tag); ok {
+			result.Tag = pos
+		}
+
+		result.Features, _ = tagset.Features(tag, tagsetName, l.tagsetLang)
+	}
+
+	return result, err
+}
+
+// mapper returns the target tagset mapping of the tagset (see tagset.MapTo), the mappings are cached
+func (l *Lemmingo) mapper(tagsetName string) func(word string, posTag string) (string, bool) {
+	if cached, ok := l.mappers.Load(tagsetName); ok {
+		return cached.(func(string, string) (string, bool))
+	}
+
+	mapPos := tagset.MapTo(l.target, tagsetName, l.tagsetLang)
+	l.mappers.Store(tagsetName, mapPos)
+
+	return mapPos
+}
@@ -0,0 +1,78 @@
+package tagset
+
+import "strings"
+
+// Composite tags separators: "+" joins the tags of the contraction parts (e.g. "PRP+MD" of "i'll"),
+// "/" separates the alternative tags of an ambiguous part (e.g. "VBD/MD" of "'d")
+const (
+	PartSeparator        = "+"
+	AlternativeSeparator = "/"
+)
+
+// Composite tells if the tag is a composite one: "+"-joined tags of the contraction parts or "/"-separated alternatives.
+func Composite(tag string) bool {
+	return len(tag) > 1 && strings.ContainsAny(tag, PartSeparator+AlternativeSeparator)
+}
+
+// SplitComposite splits the composite tag into the tags of the contraction parts, e.g. "PRP" and "VBD/MD" for "PRP+VBD/MD".
+func SplitComposite(tag string) []string {
+	if len(tag) < 2 {
+		return []string{tag}
+	}
+
+	return strings.Split(tag, PartSeparator)
+}
+
+// MapComposite gets the mapping for the tagsetName/languageTag provided to the target tagset (Universal or UPOS)
+// and returns a function mapping the composite tag to the sequence of target tags, one per contraction part,
+// e.g. "PRON VERB ADV VERB" for FreeLing "PRP+MD+RB+VBP" of "i'dn't've" to Universal Tagset.
+//
+// The alternatives of a part are mapped separately and joined back with "/" if their target tags differ
+// (e.g. "VERB" for "VBD/MD" to Universal, but "VERB/AUX" to UPOS without the word). The words of the parts
+// (e.g. the lemma parts "i", "would", "not", "have") are used by word-aware UPOS mapping (see MapTo), they could be nil.
+//
+// The function returns false if any of the tags is unknown to the tagset or the words don't match the parts.
+func MapComposite(target string, tagsetName string, languageTag string) func(words []string, posTag string) ([]string, bool) {
+	mapPos := MapTo(target, tagsetName, languageTag)
+
+	return func(words []string, posTag string) ([]string, bool) {
+		parts := SplitComposite(posTag)
+
+		if words != nil && len(words) != len(parts) {
+			return nil, false
+		}
+
+		tags := make([]string, len(parts))
+
+		for i, part := range parts {
+			var (
+				word   string
+				mapped []string
+			)
+
+			if words != nil {
+				word = words[i]
+			}
+
+			alternatives := []string{part}
+			if len(part) > 1 {
+				alternatives = strings.Split(part, AlternativeSeparator)
+			}
+
+			for _, alternative := range alternatives {
+				pos, ok := mapPos(word, alternative)
+				if !ok {
+					return nil, false
+				}
+
+				if !containsString(mapped, pos) {
+					mapped = append(mapped, pos)
+				}
+			}
+
+			tags[i] = strings.Join(mapped, AlternativeSeparator)
+		}
+
+		return tags, true
+	}
+}
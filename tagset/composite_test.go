@@ -0,0 +1,67 @@
+package tagset_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smileart/lemmingo/tagset"
+)
+
+type CompositeTestCase struct {
+	target string
+	words  string
+	tag    string
+	tags   string
+	ok     bool
+}
+
+func TestSplitComposite(t *testing.T) {
+	testCases := map[string]string{
+		"PRP+MD+RB+VBP": "PRP MD RB VBP",
+		"PRP+VBD/MD":    "PRP VBD/MD",
+		"NNS":           "NNS",
+		"+":             "+",
+	}
+
+	for tag, expected := range testCases {
+		if parts := strings.Join(tagset.SplitComposite(tag), " "); parts != expected {
+			t.Errorf("For %s we've got: '%s', expected: '%s'", tag, parts, expected)
+		}
+	}
+}
+
+func TestComposite(t *testing.T) {
+	testCases := map[string]bool{"PRP+MD": true, "VBD/MD": true, "NNS": false, "+": false, "/": false}
+
+	for tag, expected := range testCases {
+		if composite := tagset.Composite(tag); composite != expected {
+			t.Errorf("For %s we've got: %v, expected: %v", tag, composite, expected)
+		}
+	}
+}
+
+func TestMapComposite(t *testing.T) {
+	testCases := []CompositeTestCase{
+		{target: tagset.Universal, tag: "PRP+MD+RB+VBP", tags: "PRON VERB ADV VERB", ok: true},
+		{target: tagset.Universal, tag: "PRP+VBD/MD", tags: "PRON VERB", ok: true},
+		{target: tagset.UPOS, tag: "PRP+VBD/MD", tags: "PRON VERB/AUX", ok: true},
+		{target: tagset.UPOS, words: "i 'd", tag: "PRP+VBD/MD", tags: "PRON AUX", ok: true},
+		{target: tagset.UPOS, words: "i would not have", tag: "PRP+MD+RB+VBP", tags: "PRON AUX ADV AUX", ok: true},
+		{target: tagset.UPOS, words: "i would", tag: "PRP+MD+RB+VBP", ok: false},
+		{target: tagset.Universal, tag: "NNS", tags: "NOUN", ok: true},
+		{target: tagset.Universal, tag: "PRP+XX", ok: false},
+	}
+
+	for _, tc := range testCases {
+		var words []string
+		if tc.words != "" {
+			words = strings.Split(tc.words, " ")
+		}
+
+		tags, ok := tagset.MapComposite(tc.target, "freeling", "en")(words, tc.tag)
+
+		if strings.Join(tags, " ") != tc.tags || ok != tc.ok {
+			t.Errorf("For %s (%s) we've got: '%s' (ok: %v), expected: '%s' (ok: %v)", tc.tag, tc.words, strings.Join(tags, " "), ok, tc.tags, tc.ok)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package tagset
+
+import (
+	"errors"
+	"sort"
+
+	"golang.org/x/text/language"
+)
+
+// Expand returns the tags of the tagsetName/languageTag provided mapped to the universal tag (either Universal Tagset or UPOS one),
+// e.g. "NN NNP NNPS NNS" for Penn "NOUN", or "MD VB VBD VBG VBN VBP VBZ" for Penn "AUX" (the verb tags of "be"/"have" forms).
+//
+// The tags of positional tagsets (see DecodePositional) can't be enumerated, so they're never expanded.
+//
+// It returns the tags sorted, or an empty slice if there're none.
+func Expand(universal string, tagsetName string, languageTag string) []string {
+	m := registeredMapping(tagsetName, languageTag)
+	set := make(map[string]bool)
+
+	for tag, pos := range m.universal {
+		if pos == universal || m.upos[tag] == universal {
+			set[tag] = true
+		}
+	}
+
+	for _, rule := range m.rules {
+		if rule.upos != universal {
+			continue
+		}
+
+		for _, tag := range rule.tags {
+			// the rules could be shared by several tagsets
+			if _, ok := m.upos[tag]; ok {
+				set[tag] = true
+			}
+		}
+	}
+
+	return sortedKeys(set)
+}
+
+// Convert converts the tag between tagsets of the languageTag provided (registered ones, Universal or UPOS) through
+// their universal tags, e.g. WordNet "v" to Penn "MD VB VBD VBG VBN VBP VBZ", or Penn "NNS" to Universal "NOUN".
+//
+// Conversions between registered tagsets go through Universal Tagset, unless one of the sides is UPOS.
+//
+// It returns the candidate tags sorted, and true if the conversion is lossy: there're several candidates,
+// or the candidate converts back to several tags (e.g. "NNS" to "NOUN" and back), so the original tag can't be restored.
+func Convert(tag string, from string, to string, languageTag string) ([]string, bool) {
+	tags := convert(tag, from, to, languageTag)
+
+	if len(tags) != 1 {
+		return tags, true
+	}
+
+	back := convert(tags[0], to, from, languageTag)
+
+	return tags, len(back) != 1 || back[0] != tag
+}
+
+// convert converts the tag between tagsets through the pivot target tagset (UPOS if any of the sides is UPOS, otherwise Universal)
+func convert(tag string, from string, to string, languageTag string) []string {
+	if from == to {
+		return []string{tag}
+	}
+
+	pivot := Universal
+	if from == UPOS || to == UPOS {
+		pivot = UPOS
+	}
+
+	var pivotTags []string
+
+	switch from {
+	case pivot:
+		pivotTags = []string{tag}
+	case Universal:
+		pivotTags = universalToUPOS(tag)
+	default:
+		m := registeredMapping(from, languageTag)
+		set := make(map[string]bool)
+
+		if pivot == Universal {
+			if pos, ok := m.toUniversal(tag); ok {
+				set[pos] = true
+			}
+		} else {
+			if pos, ok := m.toUPOS(tag); ok {
+				set[pos] = true
+			}
+
+			for _, rule := range m.rules {
+				if containsString(rule.tags, tag) {
+					set[rule.upos] = true
+				}
+			}
+		}
+
+		pivotTags = sortedKeys(set)
+	}
+
+	set := make(map[string]bool)
+
+	for _, pos := range pivotTags {
+		switch to {
+		case pivot:
+			set[pos] = true
+		case Universal:
+			set[uposToUniversal(pos)] = true
+		default:
+			for _, t := range Expand(pos, to, languageTag) {
+				// Expand matches both mappings, the candidates should match the pivot one
+				if convertsTo(t, to, pos, pivot, languageTag) {
+					set[t] = true
+				}
+			}
+		}
+	}
+
+	return sortedKeys(set)
+}
+
+// convertsTo checks if the tag of the tagset maps to the pivot tag (either directly or by a word rule)
+func convertsTo(tag string, tagsetName string, pos string, pivot string, languageTag string) bool {
+	m := registeredMapping(tagsetName, languageTag)
+
+	if pivot == Universal {
+		universal, _ := m.toUniversal(tag)
+
+		return universal == pos
+	}
+
+	if upos, _ := m.toUPOS(tag); upos == pos {
+		return true
+	}
+
+	for _, rule := range m.rules {
+		if rule.upos == pos && containsString(rule.tags, tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// universalToUPOS returns UPOS tags of the Universal Tagset tag, e.g. "VERB" and "AUX" for "VERB"
+func universalToUPOS(universal string) []string {
+	var tags []string
+
+	for upos := range uposTags {
+		if uposToUniversal(upos) == universal {
+			tags = append(tags, upos)
+		}
+	}
+
+	sort.Strings(tags)
+
+	return tags
+}
+
+// uposToUniversal returns Universal Tagset tag of the UPOS tag
+func uposToUniversal(upos string) string {
+	if universal, ok := uposUniversal[upos]; ok {
+		return universal
+	}
+
+	return upos
+}
+
+// registeredMapping returns the registered mapping of the tagset name/language (BCP 47) or panics
+func registeredMapping(tagsetName string, languageTag string) *mapping {
+	base, _ := language.Make(languageTag).Base()
+
+	m, ok := lookupMapping(tagsetName, base.String())
+
+	// if we wanted a certain mapping, but can't load one there's no way to recover
+	if !ok {
+		panic(errors.New("Tagset mapping for `" + tagsetName + "_" + base.String() + "` was not found!"))
+	}
+
+	return m
+}
+
+// sortedKeys returns the keys of the set sorted
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+
+	for key := range set {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// containsString checks if the items contain the item
+func containsString(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+
+	return false
+}
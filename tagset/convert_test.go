@@ -0,0 +1,58 @@
+package tagset_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smileart/lemmingo/tagset"
+)
+
+type ConvertTestCase struct {
+	tag   string
+	from  string
+	to    string
+	tags  string
+	lossy bool
+}
+
+func TestExpand(t *testing.T) {
+	testCases := map[string]string{
+		"NOUN":  "NN NNP NNPS NNS",
+		"PROPN": "NNP NNPS",
+		"AUX":   "MD VB VBD VBG VBN VBP VBZ",
+		"SCONJ": "IN",
+		"CONJ":  "CC",
+		"INTJ":  "UH",
+		"FOO":   "",
+	}
+
+	for universal, expected := range testCases {
+		if tags := strings.Join(tagset.Expand(universal, "penn", "en-US"), " "); tags != expected {
+			t.Errorf("For %s we've got: '%s', expected: '%s'", universal, tags, expected)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	testCases := []ConvertTestCase{
+		{tag: "v", from: "wordnet", to: "penn", tags: "MD VB VBD VBG VBN VBP VBZ", lossy: true},
+		{tag: "n", from: "wordnet", to: tagset.Universal, tags: "NOUN"},
+		{tag: "NOUN", from: tagset.Universal, to: "wordnet", tags: "n"},
+		{tag: "NNS", from: "penn", to: tagset.Universal, tags: "NOUN", lossy: true},
+		{tag: "NNP", from: "penn", to: tagset.UPOS, tags: "PROPN", lossy: true},
+		{tag: "UH", from: "penn", to: tagset.UPOS, tags: "INTJ"},
+		{tag: "VBZ", from: "penn", to: tagset.UPOS, tags: "AUX VERB", lossy: true},
+		{tag: "CC", from: "penn", to: "freeling", tags: "CC"},
+		{tag: "AUX", from: tagset.UPOS, to: tagset.Universal, tags: "VERB", lossy: true},
+		{tag: "CONJ", from: tagset.Universal, to: tagset.UPOS, tags: "CCONJ SCONJ", lossy: true},
+		{tag: "XX", from: "penn", to: "wordnet", tags: "", lossy: true},
+	}
+
+	for _, tc := range testCases {
+		tags, lossy := tagset.Convert(tc.tag, tc.from, tc.to, "en")
+
+		if strings.Join(tags, " ") != tc.tags || lossy != tc.lossy {
+			t.Errorf("For %s (%s -> %s) we've got: '%s' (lossy: %v), expected: '%s' (lossy: %v)", tc.tag, tc.from, tc.to, strings.Join(tags, " "), lossy, tc.tags, tc.lossy)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package tagset
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Auto is the tagset name making the dictionary loader detect the tagset by the dictionary tags (see Detect)
+const Auto = "auto"
+
+// Match is the registered tagset scored against the tags
+type Match struct {
+	Name      string   // tagset name, e.g. "penn"
+	Lang      string   // base language, e.g. "en"
+	Coverage  float64  // share of the distinct tags known to the tagset
+	Precision float64  // share of the tagset tags among the known ones (0 for positional tagsets), the tie-breaker
+	Unknown   []string // tags unknown to the tagset, sorted
+}
+
+// Detection is the result of the tagset detection: the best match with the confidence score and all the matches
+type Detection struct {
+	Best       Match
+	Confidence float64 // coverage margin of the best match over the runner-up tagset (0 if they're equally good or there's no match)
+	Matches    []Match // the matches sorted from the best one
+}
+
+// Detect scores the tags (a dictionary tag inventory or a sample of input tags, duplicates are ignored) against
+// all the registered tagsets of the languageTag provided (BCP 47, only the base language is used, or all languages if empty)
+// and the target tagsets (Universal and UPOS, with empty language), e.g. FreeLing tags of en.lmm match "freeling" fully,
+// while "penn" misses the composite ones (e.g. "PRP+MD").
+//
+// The tagsets are ranked by the share of the tags they know, the ones with fewer tags win the ties (e.g. "penn" over
+// "freeling" for "NN VBD" sample), so the confidence (the coverage margin over the runner-up) shows how certain the match is.
+//
+// The languages of the same tagset sharing the tags (e.g. FreeLing "es" and "pt") aren't told apart, pass the languageTag for them.
+//
+// It returns the detection, which best match is empty if no tagset knows any of the tags.
+func Detect(tags []string, languageTag string) Detection {
+	var lang string
+
+	if languageTag != "" {
+		base, _ := language.Make(languageTag).Base()
+		lang = base.String()
+	}
+
+	distinct := make(map[string]bool, len(tags))
+
+	for _, tag := range tags {
+		distinct[tag] = true
+	}
+
+	registryMu.RLock()
+
+	var detection Detection
+
+	for key, m := range registry {
+		i := strings.LastIndex(key, "_")
+		if lang != "" && key[i+1:] != lang {
+			continue
+		}
+
+		detection.Matches = append(detection.Matches, m.match(key[:i], key[i+1:], distinct))
+	}
+
+	registryMu.RUnlock()
+
+	// the tags could be the target tagsets ones already
+	for _, target := range []string{Universal, UPOS} {
+		m := &mapping{universal: make(map[string]string)}
+
+		for tag := range targetTags(target) {
+			m.universal[tag] = tag
+		}
+
+		detection.Matches = append(detection.Matches, m.match(target, "", distinct))
+	}
+
+	sort.Slice(detection.Matches, func(i, j int) bool {
+		a, b := detection.Matches[i], detection.Matches[j]
+
+		if a.Coverage != b.Coverage {
+			return a.Coverage > b.Coverage
+		}
+
+		if a.Precision != b.Precision {
+			return a.Precision > b.Precision
+		}
+
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+
+		return a.Lang < b.Lang
+	})
+
+	if len(detection.Matches) == 0 || detection.Matches[0].Coverage == 0 {
+		return detection
+	}
+
+	detection.Best, detection.Confidence = detection.Matches[0], detection.Matches[0].Coverage
+
+	// the languages of the same tagset (e.g. FreeLing EAGLES ones) can't be told apart by the tags
+	for _, m := range detection.Matches[1:] {
+		if m.Name != detection.Best.Name {
+			detection.Confidence -= m.Coverage
+			break
+		}
+	}
+
+	return detection
+}
+
+// match scores the distinct tags against the mapping
+func (m *mapping) match(name string, lang string, tags map[string]bool) Match {
+	match := Match{Name: name, Lang: lang}
+	known := 0
+
+	for tag := range tags {
+		if _, ok := m.toUniversal(tag); ok {
+			known++
+		} else {
+			match.Unknown = append(match.Unknown, tag)
+		}
+	}
+
+	sort.Strings(match.Unknown)
+
+	if len(tags) > 0 {
+		match.Coverage = float64(known) / float64(len(tags))
+	}
+
+	// the normalised tags (e.g. Brown "NN-TL") aren't counted among the tagset ones
+	if len(m.universal) > 0 {
+		match.Precision = math.Min(float64(known)/float64(len(m.universal)), 1)
+	}
+
+	return match
+}
+
+// targetTags returns the tags of the target tagset
+func targetTags(target string) map[string]bool {
+	if target == UPOS {
+		return uposTags
+	}
+
+	return universalTags
+}
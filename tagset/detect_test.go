@@ -0,0 +1,48 @@
+package tagset_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smileart/lemmingo/tagset"
+)
+
+type DetectTestCase struct {
+	tags       string
+	lang       string
+	name       string
+	tagsetLang string
+	confident  bool
+}
+
+func TestDetect(t *testing.T) {
+	testCases := []DetectTestCase{
+		{tags: "NN VBD DT NN", lang: "en", name: "penn", tagsetLang: "en", confident: false}, // FreeLing knows these as well
+		{tags: "NN PRP+MD VBD/MD", lang: "en", name: "freeling", tagsetLang: "en", confident: true},
+		{tags: "NN-TL BEDZ AT", lang: "", name: "brown", tagsetLang: "en", confident: true},
+		{tags: "VAFIN NN ART", lang: "", name: "stts", tagsetLang: "de", confident: true},
+		{tags: "NCMS000 VMIP3S0 Fc", lang: "es", name: "freeling", tagsetLang: "es", confident: true},
+		{tags: "NOUN VERB .", lang: "en", name: tagset.Universal, tagsetLang: "", confident: true},
+		{tags: "PROPN AUX VERB", lang: "en", name: tagset.UPOS, tagsetLang: "", confident: true},
+		{tags: "foo bar", lang: "en", name: "", tagsetLang: "", confident: false},
+	}
+
+	for _, tc := range testCases {
+		detection := tagset.Detect(strings.Fields(tc.tags), tc.lang)
+
+		if detection.Best.Name != tc.name || detection.Best.Lang != tc.tagsetLang || (detection.Confidence > 0) != tc.confident {
+			t.Errorf("For '%s' (%s) we've got: %s_%s (confidence: %.2f), expected: %s_%s (confident: %v)",
+				tc.tags, tc.lang, detection.Best.Name, detection.Best.Lang, detection.Confidence, tc.name, tc.tagsetLang, tc.confident)
+		}
+	}
+}
+
+func TestDetectUnknown(t *testing.T) {
+	detection := tagset.Detect([]string{"NN", "PRP+MD", "PRP+MD"}, "en")
+
+	for _, m := range detection.Matches {
+		if m.Name == "penn" && (m.Coverage != 0.5 || len(m.Unknown) != 1 || m.Unknown[0] != "PRP+MD") {
+			t.Errorf("We've got unexpected penn match: %+v", m)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package tagset
+
+import (
+	"sort"
+	"strings"
+)
+
+// PositionalTag is the positional (EAGLES) tag decoded into the universal tags and morphological features
+type PositionalTag struct {
+	Universal string            // A Universal Part-of-Speech Tagset tag
+	UPOS      string            // Universal Dependencies v2 UPOS tag
+	Features  map[string]string // Universal Dependencies features, e.g. {"Gender": "Masc", "Number": "Sing"}
+}
+
+// eaglesTable is the position tables of the EAGLES tagset by category (the first character of the tag)
+type eaglesTable map[byte]eaglesCategory
+
+// eaglesCategory describes the tags of the category: their universal tags and the values of every position after the category
+type eaglesCategory struct {
+	universal string
+	upos      string
+	subtypes  map[byte]string   // UPOS by the second character (the type), e.g. "P" (proper) noun is "PROPN"
+	positions []map[byte]string // UD features ("Name=Value|...", could be empty) by the character of the position
+	free      bool              // any characters could follow the category (e.g. FreeLing punctuation "Fat" or "Fc")
+}
+
+// FEATS formats the features as Universal Dependencies FEATS column: "Name=Value" pairs sorted by name and joined with "|"
+// (or "_" if there're none), e.g. "Gender=Masc|Number=Sing".
+func (t PositionalTag) FEATS() string {
+	return formatFeatures(t.Features)
+}
+
+// DecodePositional decodes the positional tag of the registered tagsetName/languageTag (BCP 47, only the base language is used)
+// by its position tables, e.g. FreeLing "NCMS000" (es) to "NOUN" with "Gender=Masc|Number=Sing",
+// or "VMIP3S0" to "VERB" with "Mood=Ind|Number=Sing|Person=3|Tense=Pres|VerbForm=Fin".
+//
+// The "0" character marks the position as unspecified, the trailing positions could be omitted (e.g. "NC" or "Fc").
+//
+// It returns the decoded tag and false if the tagset isn't positional, or the tag has unknown category or position values.
+func DecodePositional(tag string, tagsetName string, languageTag string) (PositionalTag, bool) {
+	m := registeredMapping(tagsetName, languageTag)
+
+	if m.positional == nil {
+		return PositionalTag{}, false
+	}
+
+	return m.positional.decode(tag)
+}
+
+// decode decodes the tag by the position tables
+func (t eaglesTable) decode(tag string) (PositionalTag, bool) {
+	if tag == "" {
+		return PositionalTag{}, false
+	}
+
+	category, ok := t[tag[0]]
+	if !ok {
+		return PositionalTag{}, false
+	}
+
+	decoded := PositionalTag{Universal: category.universal, UPOS: category.upos, Features: make(map[string]string)}
+
+	if category.free {
+		return decoded, true
+	}
+
+	if len(tag) > 1 {
+		if upos, ok := category.subtypes[tag[1]]; ok {
+			decoded.UPOS = upos
+		}
+	}
+
+	for i := 1; i < len(tag); i++ {
+		if tag[i] == '0' {
+			continue
+		}
+
+		if i > len(category.positions) {
+			return PositionalTag{}, false
+		}
+
+		features, ok := category.positions[i-1][tag[i]]
+		if !ok {
+			return PositionalTag{}, false
+		}
+
+		for _, feature := range strings.Split(features, "|") {
+			if kv := strings.SplitN(feature, "=", 2); len(kv) == 2 {
+				decoded.Features[kv[0]] = kv[1]
+			}
+		}
+	}
+
+	return decoded, true
+}
+
+// formatFeatures formats the features as Universal Dependencies FEATS column (sorted by name case-insensitively)
+func formatFeatures(features map[string]string) string {
+	if len(features) == 0 {
+		return "_"
+	}
+
+	pairs := make([]string, 0, len(features))
+
+	for name, value := range features {
+		pairs = append(pairs, name+"="+value)
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		return strings.ToLower(pairs[i]) < strings.ToLower(pairs[j])
+	})
+
+	return strings.Join(pairs, "|")
+}
@@ -0,0 +1,151 @@
+package tagset
+
+// EAGLES position values shared by the tables
+var (
+	eaglesGender = map[byte]string{'M': "Gender=Masc", 'F': "Gender=Fem", 'C': "Gender=Com", 'N': "Gender=Neut"}
+	eaglesNumber = map[byte]string{'S': "Number=Sing", 'P': "Number=Plur", 'N': ""} // "N" is invariable
+	eaglesPerson = map[byte]string{'1': "Person=1", '2': "Person=2", '3': "Person=3"}
+	eaglesPsor   = map[byte]string{'S': "Number[psor]=Sing", 'P': "Number[psor]=Plur", 'N': ""}
+)
+
+// FreeLing Project - ES, CA, IT, PT (EAGLES positional tags of Romance languages, e.g. "NCMS000", "VMIP3S0", "AQ0FP00")
+// REF: https://freeling-user-manual.readthedocs.io/en/latest/tagsets/tagset-es/
+// REF: https://freeling-user-manual.readthedocs.io/en/latest/tagsets/tagset-ca/
+// REF: https://freeling-user-manual.readthedocs.io/en/latest/tagsets/tagset-it/
+// REF: https://freeling-user-manual.readthedocs.io/en/latest/tagsets/tagset-pt/
+var freelingRomance = eaglesTable{
+	// adjective - type, degree, gender, number, possessor person, possessor number (older "AQ0MS0" tags mark participles with "P")
+	'A': {universal: "ADJ", upos: "ADJ", positions: []map[byte]string{
+		{'Q': "", 'O': "NumType=Ord", 'P': "Poss=Yes"},
+		{'S': "Degree=Sup", 'V': "", 'A': "", 'D': "", 'C': "Degree=Cmp"},
+		eaglesGender,
+		eaglesNumber,
+		{'1': "Person[psor]=1", '2': "Person[psor]=2", '3': "Person[psor]=3", 'P': "VerbForm=Part"},
+		eaglesPsor,
+	}},
+	// conjunction - type (coordinating, subordinating)
+	'C': {universal: "CONJ", upos: "CCONJ", subtypes: map[byte]string{'S': "SCONJ"}, positions: []map[byte]string{
+		{'C': "", 'S': ""},
+	}},
+	// determiner - type, person, gender, number, possessor number
+	'D': {universal: "DET", upos: "DET", positions: []map[byte]string{
+		{'A': "PronType=Art", 'D': "PronType=Dem", 'I': "PronType=Ind", 'P': "Poss=Yes|PronType=Prs", 'T': "PronType=Int", 'E': "PronType=Exc", 'N': "NumType=Card"},
+		eaglesPerson,
+		eaglesGender,
+		eaglesNumber,
+		eaglesPsor,
+	}},
+	// noun - type, gender, number, named entity class, named entity subclass, degree (augmentative, diminutive)
+	'N': {universal: "NOUN", upos: "NOUN", subtypes: map[byte]string{'P': "PROPN"}, positions: []map[byte]string{
+		{'C': "", 'P': ""},
+		eaglesGender,
+		eaglesNumber,
+		{'S': "", 'G': "", 'O': "", 'V': ""},
+		{},
+		{'A': "", 'D': ""},
+	}},
+	// pronoun - type, person, gender, number, case, possessor number, politeness
+	'P': {universal: "PRON", upos: "PRON", positions: []map[byte]string{
+		{'P': "PronType=Prs", 'D': "PronType=Dem", 'X': "Poss=Yes|PronType=Prs", 'I': "PronType=Ind", 'T': "PronType=Int", 'R': "PronType=Rel", 'E': "PronType=Exc", 'N': "NumType=Card"},
+		eaglesPerson,
+		eaglesGender,
+		eaglesNumber,
+		{'N': "Case=Nom", 'A': "Case=Acc", 'D': "Case=Dat", 'O': ""},
+		eaglesPsor,
+		{'P': "Polite=Form"},
+	}},
+	// adverb - type (general, negative)
+	'R': {universal: "ADV", upos: "ADV", positions: []map[byte]string{
+		{'G': "", 'N': "Polarity=Neg"},
+	}},
+	// adposition - type, form (simple, contracted with the article), gender, number
+	'S': {universal: "ADP", upos: "ADP", positions: []map[byte]string{
+		{'P': "AdpType=Prep"},
+		{'S': "", 'C': "PronType=Art"},
+		eaglesGender,
+		eaglesNumber,
+	}},
+	// verb - type (main, auxiliary, semiauxiliary), mood, tense, person, number, gender
+	'V': {universal: "VERB", upos: "VERB", subtypes: map[byte]string{'A': "AUX", 'S': "AUX"}, positions: []map[byte]string{
+		{'M': "", 'A': "", 'S': ""},
+		{'I': "Mood=Ind|VerbForm=Fin", 'S': "Mood=Sub|VerbForm=Fin", 'M': "Mood=Imp|VerbForm=Fin", 'P': "VerbForm=Part", 'G': "VerbForm=Ger", 'N': "VerbForm=Inf"},
+		{'P': "Tense=Pres", 'I': "Tense=Imp", 'F': "Tense=Fut", 'S': "Tense=Past", 'C': "Mood=Cnd"},
+		eaglesPerson,
+		eaglesNumber,
+		eaglesGender,
+	}},
+	'Z': {universal: "NUM", upos: "NUM", free: true}, // number - "Z", "Zd" (partitive), "Zm" (currency), "Zp" (ratio), "Zu" (unit)
+	'W': {universal: "NUM", upos: "NUM", free: true}, // date/time
+	'I': {universal: "X", upos: "INTJ", free: true},  // interjection
+	'F': {universal: ".", upos: "PUNCT", free: true}, // punctuation - "Fc", "Fp", "Fat", "Fpa", etc.
+}
+
+// Russian EAGLES position values
+var (
+	russianCase    = map[byte]string{'N': "Case=Nom", 'G': "Case=Gen", 'D': "Case=Dat", 'F': "Case=Acc", 'C': "Case=Ins", 'O': "Case=Loc", 'P': "Case=Par", 'L': "Case=Loc", 'V': "Case=Voc"}
+	russianGender  = map[byte]string{'M': "Gender=Masc", 'F': "Gender=Fem", 'A': "Gender=Neut"}
+	russianNumber  = map[byte]string{'S': "Number=Sing", 'P': "Number=Plur"}
+	russianAnimacy = map[byte]string{'A': "Animacy=Anim", 'I': "Animacy=Inan"}
+	russianAspect  = map[byte]string{'P': "Aspect=Perf", 'I': "Aspect=Imp"}
+	russianVoice   = map[byte]string{'A': "Voice=Act", 'P': "Voice=Pass"}
+)
+
+// FreeLing Project - RU (EAGLES-like positional tags, e.g. "NCNSMI", "VIPS3")
+// REF: https://freeling-user-manual.readthedocs.io/en/latest/tagsets/tagset-ru/
+var freelingRu = eaglesTable{
+	// adjective - case, number, gender, animacy, form (short, full), degree (comparative, superlative)
+	'A': {universal: "ADJ", upos: "ADJ", positions: []map[byte]string{
+		russianCase,
+		russianNumber,
+		russianGender,
+		russianAnimacy,
+		{'S': "Variant=Short", 'F': ""},
+		{'E': "Degree=Cmp", 'S': "Degree=Sup"},
+	}},
+	'B': {universal: "ADP", upos: "ADP", free: true},    // preposition
+	'C': {universal: "CONJ", upos: "CCONJ", free: true}, // conjunction
+	// adverb - degree (comparative, superlative)
+	'D': {universal: "ADV", upos: "ADV", positions: []map[byte]string{
+		{'E': "Degree=Cmp", 'S': "Degree=Sup"},
+	}},
+	// pronoun - case, number, gender, animacy
+	'E': {universal: "PRON", upos: "PRON", positions: []map[byte]string{
+		russianCase,
+		russianNumber,
+		russianGender,
+		russianAnimacy,
+	}},
+	'J': {universal: "X", upos: "INTJ", free: true}, // interjection
+	// noun - type (common, proper), case, number, gender, animacy
+	'N': {universal: "NOUN", upos: "NOUN", subtypes: map[byte]string{'P': "PROPN"}, positions: []map[byte]string{
+		{'C': "", 'P': ""},
+		russianCase,
+		russianNumber,
+		russianGender,
+		russianAnimacy,
+	}},
+	// participle - case, number, gender, tense, voice, aspect, form (short, full)
+	'Q': {universal: "VERB", upos: "VERB", positions: []map[byte]string{
+		russianCase,
+		russianNumber,
+		russianGender,
+		{'P': "Tense=Pres|VerbForm=Part", 'S': "Tense=Past|VerbForm=Part"},
+		russianVoice,
+		russianAspect,
+		{'S': "Variant=Short", 'F': ""},
+	}},
+	'T': {universal: "PRT", upos: "PART", free: true}, // particle
+	// verb - mood, tense, number, person, gender, aspect, voice
+	'V': {universal: "VERB", upos: "VERB", positions: []map[byte]string{
+		{'I': "Mood=Ind|VerbForm=Fin", 'M': "Mood=Imp|VerbForm=Fin", 'N': "VerbForm=Inf", 'G': "VerbForm=Conv"},
+		{'P': "Tense=Pres", 'S': "Tense=Past", 'F': "Tense=Fut"},
+		russianNumber,
+		{'1': "Person=1", '2': "Person=2", '3': "Person=3"},
+		russianGender,
+		russianAspect,
+		russianVoice,
+	}},
+	'Y': {universal: "X", upos: "X", free: true},     // abbreviation
+	'Z': {universal: "NUM", upos: "NUM", free: true}, // numeral
+	'F': {universal: ".", upos: "PUNCT", free: true}, // punctuation
+}
@@ -0,0 +1,55 @@
+package tagset_test
+
+import (
+	"testing"
+
+	"github.com/smileart/lemmingo/tagset"
+)
+
+type PositionalTestCase struct {
+	tag       string
+	lang      string
+	universal string
+	upos      string
+	feats     string
+	ok        bool
+}
+
+func TestDecodePositional(t *testing.T) {
+	testCases := []PositionalTestCase{
+		{tag: "NCMS000", lang: "es", universal: "NOUN", upos: "NOUN", feats: "Gender=Masc|Number=Sing", ok: true},
+		{tag: "NP00000", lang: "es", universal: "NOUN", upos: "PROPN", feats: "_", ok: true},
+		{tag: "VMIP3S0", lang: "es", universal: "VERB", upos: "VERB", feats: "Mood=Ind|Number=Sing|Person=3|Tense=Pres|VerbForm=Fin", ok: true},
+		{tag: "VAIS3P0", lang: "ca", universal: "VERB", upos: "AUX", feats: "Mood=Ind|Number=Plur|Person=3|Tense=Past|VerbForm=Fin", ok: true},
+		{tag: "AQ0FP00", lang: "it", universal: "ADJ", upos: "ADJ", feats: "Gender=Fem|Number=Plur", ok: true},
+		{tag: "DA0MS0", lang: "pt-BR", universal: "DET", upos: "DET", feats: "Gender=Masc|Number=Sing|PronType=Art", ok: true},
+		{tag: "CS", lang: "es", universal: "CONJ", upos: "SCONJ", feats: "_", ok: true},
+		{tag: "Fc", lang: "es", universal: ".", upos: "PUNCT", feats: "_", ok: true},
+		{tag: "NCNSMI", lang: "ru", universal: "NOUN", upos: "NOUN", feats: "Animacy=Inan|Case=Nom|Gender=Masc|Number=Sing", ok: true},
+		{tag: "NNS", lang: "es", feats: "_", ok: false},
+		{tag: "NCMS0001", lang: "es", feats: "_", ok: false},
+		{tag: "NN", lang: "en", feats: "_", ok: false},
+	}
+
+	for _, tc := range testCases {
+		decoded, ok := tagset.DecodePositional(tc.tag, "freeling", tc.lang)
+
+		if decoded.Universal != tc.universal || decoded.UPOS != tc.upos || decoded.FEATS() != tc.feats || ok != tc.ok {
+			t.Errorf("For %s (%s) we've got: %s %s %s (ok: %v), expected: %s %s %s (ok: %v)", tc.tag, tc.lang, decoded.Universal, decoded.UPOS, decoded.FEATS(), ok, tc.universal, tc.upos, tc.feats, tc.ok)
+		}
+	}
+}
+
+func TestMapPositional(t *testing.T) {
+	if pos, ok := tagset.MapPos("freeling", "es-ES")("NCFP000"); pos != "NOUN" || !ok {
+		t.Errorf("For NCFP000 we've got: '%s' (ok: %v), expected: 'NOUN'", pos, ok)
+	}
+
+	if pos, ok := tagset.MapTo(tagset.UPOS, "freeling", "es")("ha", "VAIP3S0"); pos != "AUX" || !ok {
+		t.Errorf("For VAIP3S0 we've got: '%s' (ok: %v), expected: 'AUX'", pos, ok)
+	}
+
+	if tags, lossy := tagset.Convert("NCMS000", "freeling", tagset.Universal, "es"); len(tags) != 1 || tags[0] != "NOUN" || !lossy {
+		t.Errorf("For NCMS000 we've got: %v (lossy: %v), expected: [NOUN] (lossy: true)", tags, lossy)
+	}
+}
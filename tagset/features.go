@@ -0,0 +1,38 @@
+package tagset
+
+// Features returns the Universal Dependencies features encoded by the tag of the registered tagsetName/languageTag
+// as FEATS column, e.g. "Tense=Past|VerbForm=Fin" for Penn "VBD", "Number=Plur" for "NNS", "Degree=Sup" for "JJS",
+// or "Gender=Masc|Number=Sing" for FreeLing "NCMS000" (es, see DecodePositional).
+//
+// The features are known for Penn Treebank Project, FreeLing English, Brown, CLAWS7, STTS and Penn Chinese Treebank tagsets
+// (the modifiers of Brown and CLAWS7 tags are normalised, e.g. "NNS-TL"), and the positional FreeLing ones (Spanish, Catalan,
+// Italian, Portuguese and Russian). WordNet tagset (which tags encode none), composite tags (see MapComposite)
+// and the tags of the tagsets registered at runtime have none.
+//
+// It returns the features ("_" if there're none) and false if the tag is unknown to the tagset.
+func Features(tag string, tagsetName string, languageTag string) (string, bool) {
+	m := registeredMapping(tagsetName, languageTag)
+
+	if m.positional != nil {
+		decoded, ok := m.positional.decode(tag)
+		if !ok {
+			return "_", false
+		}
+
+		return decoded.FEATS(), true
+	}
+
+	if _, ok := m.toUniversal(tag); !ok {
+		return "_", false
+	}
+
+	if _, ok := m.features[tag]; !ok && m.normalize != nil {
+		tag = m.normalize(tag)
+	}
+
+	if features, ok := m.features[tag]; ok {
+		return features, true
+	}
+
+	return "_", true
+}
@@ -0,0 +1,44 @@
+package tagset_test
+
+import (
+	"testing"
+
+	"github.com/smileart/lemmingo/tagset"
+)
+
+type FeaturesTestCase struct {
+	tag      string
+	tagset   string
+	lang     string
+	features string
+	ok       bool
+}
+
+func TestFeatures(t *testing.T) {
+	testCases := []FeaturesTestCase{
+		{tag: "VBD", tagset: "penn", lang: "en", features: "Tense=Past|VerbForm=Fin", ok: true},
+		{tag: "NNS", tagset: "penn", lang: "en", features: "Number=Plur", ok: true},
+		{tag: "JJS", tagset: "freeling", lang: "en", features: "Degree=Sup", ok: true},
+		{tag: "VBZ", tagset: "freeling", lang: "en", features: "Number=Sing|Person=3|Tense=Pres|VerbForm=Fin", ok: true},
+		{tag: "CC", tagset: "penn", lang: "en", features: "_", ok: true},
+		{tag: "PRP+MD", tagset: "freeling", lang: "en", features: "_", ok: true},
+		{tag: "v", tagset: "wordnet", lang: "en", features: "_", ok: true},
+		{tag: "NCMS000", tagset: "freeling", lang: "es", features: "Gender=Masc|Number=Sing", ok: true},
+		{tag: "NNS", tagset: "brown", lang: "en", features: "Number=Plur", ok: true},
+		{tag: "NNS-TL", tagset: "brown", lang: "en", features: "Number=Plur", ok: true},
+		{tag: "VVD", tagset: "claws7", lang: "en", features: "Tense=Past|VerbForm=Fin", ok: true},
+		{tag: "JJT", tagset: "claws7", lang: "en", features: "Degree=Sup", ok: true},
+		{tag: "VVPP", tagset: "stts", lang: "de", features: "Aspect=Perf|VerbForm=Part", ok: true},
+		{tag: "NN", tagset: "stts", lang: "de", features: "_", ok: true},
+		{tag: "OD", tagset: "ctb", lang: "zh", features: "NumType=Ord", ok: true},
+		{tag: "XX", tagset: "penn", lang: "en", features: "_", ok: false},
+	}
+
+	for _, tc := range testCases {
+		features, ok := tagset.Features(tc.tag, tc.tagset, tc.lang)
+
+		if features != tc.features || ok != tc.ok {
+			t.Errorf("For %s (%s_%s) we've got: '%s' (ok: %v), expected: '%s' (ok: %v)", tc.tag, tc.tagset, tc.lang, features, ok, tc.features, tc.ok)
+		}
+	}
+}
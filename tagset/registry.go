@@ -0,0 +1,286 @@
+package tagset
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// Info describes a registered tagset mapping
+type Info struct {
+	Name       string // tagset name, e.g. "penn"
+	Lang       string // base language, e.g. "en"
+	Tags       int    // number of mapped tags (0 for positional tagsets)
+	Builtin    bool   // shipped with the package (see tagset_data.go)
+	Positional bool   // the tags are decoded by the position tables (see DecodePositional)
+}
+
+// mapping is a registered tagset mapping to both target tagsets
+type mapping struct {
+	universal  map[string]string
+	upos       map[string]string
+	rules      []wordRule
+	features   map[string]string // UD FEATS by tag
+	positional eaglesTable
+	normalize  func(tag string) string // strips the tag modifiers unknown to the mapping (e.g. Brown "-TL" title suffix)
+	builtin    bool
+}
+
+var (
+	registryMu sync.RWMutex
+
+	// registry maps "<name>_<lang>" keys to the tagset mappings
+	registry = map[string]*mapping{
+		"penn_en":     {universal: pennEn, upos: pennEnUPOS, rules: enUPOSRules, features: enFeatures, builtin: true},         // Penn Treebank Project, EN
+		"freeling_en": {universal: freelingEn, upos: freelingEnUPOS, rules: enUPOSRules, features: enFeatures, builtin: true}, // FreeLing Project, EN
+		"wordnet_en":  {universal: wordnetEn, upos: wordnetEnUPOS, builtin: true},                                             // WordNet Project, EN
+		"freeling_es": {positional: freelingRomance, builtin: true},                                                           // FreeLing Project, ES (EAGLES)
+		"freeling_ca": {positional: freelingRomance, builtin: true},                                                           // FreeLing Project, CA (EAGLES)
+		"freeling_it": {positional: freelingRomance, builtin: true},                                                           // FreeLing Project, IT (EAGLES)
+		"freeling_pt": {positional: freelingRomance, builtin: true},                                                           // FreeLing Project, PT (EAGLES)
+		"freeling_ru": {positional: freelingRu, builtin: true},                                                                // FreeLing Project, RU (EAGLES-like)
+		"brown_en":    builtinMapping(brownEnUPOS, brownEnFeatures, normalizeBrown),                                           // Brown Corpus (NLTK), EN
+		"claws7_en":   builtinMapping(claws7EnUPOS, claws7EnFeatures, normalizeCLAWS),                                         // CLAWS7 (BNC), EN
+		"stts_de":     builtinMapping(sttsDeUPOS, sttsDeFeatures, nil),                                                        // Stuttgart-Tübingen Tagset, DE
+		"ctb_zh":      builtinMapping(ctbZhUPOS, ctbZhFeatures, nil),                                                          // Penn Chinese Treebank (Stanford), ZH
+	}
+)
+
+// universalTags are the tags of A Universal Part-of-Speech Tagset
+var universalTags = toSet([]string{"NOUN", "VERB", "ADJ", "ADV", "PRON", "DET", "ADP", "NUM", "CONJ", "PRT", ".", "X"})
+
+// uposTags are the tags of Universal Dependencies v2 UPOS
+var uposTags = toSet([]string{
+	"ADJ", "ADP", "ADV", "AUX", "CCONJ", "DET", "INTJ", "NOUN", "NUM", "PART", "PRON", "PROPN", "PUNCT", "SCONJ", "SYM", "VERB", "X",
+})
+
+// uposUniversal maps UPOS tags missing from Universal Tagset to the Universal Tagset ones
+var uposUniversal = map[string]string{
+	"AUX":   "VERB",
+	"PROPN": "NOUN",
+	"CCONJ": "CONJ",
+	"SCONJ": "CONJ",
+	"PART":  "PRT",
+	"PUNCT": ".",
+	"SYM":   "X",
+	"INTJ":  "X",
+}
+
+// universalUPOS maps Universal Tagset tags missing from UPOS to the UPOS ones
+var universalUPOS = map[string]string{
+	"CONJ": "CCONJ",
+	"PRT":  "PART",
+	".":    "PUNCT",
+}
+
+// Register registers the mapping of the tagset name/language (BCP 47, only the base language is used) to either
+// Universal Tagset or UPOS tags (or both), so it could be used by MapPos, MapTo and dictionary loading exactly like the built-in ones.
+//
+// The tags of the other target tagset are derived from the mapped ones, e.g. "AUX" is "VERB" and "CONJ" is "CCONJ".
+//
+// It returns an error if the tagset is already registered or the mapping has tags of neither target tagset.
+func Register(name string, lang string, tags map[string]string) error {
+	if name == "" {
+		return errors.New("Tagset name should not be empty!")
+	}
+
+	base, confidence := language.Make(lang).Base()
+	if lang == "" || confidence == language.No {
+		return errors.New("Tagset language `" + lang + "` is not a valid BCP 47 language tag!")
+	}
+
+	m, err := newMapping(tags)
+	if err != nil {
+		return err
+	}
+
+	key := name + "_" + base.String()
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, ok := registry[key]; ok {
+		return errors.New("Tagset `" + key + "` is already registered!")
+	}
+
+	registry[key] = m
+
+	return nil
+}
+
+// newMapping creates the mapping to both target tagsets from the tags mapped to either of them
+func newMapping(tags map[string]string) (*mapping, error) {
+	m := &mapping{universal: make(map[string]string, len(tags)), upos: make(map[string]string, len(tags))}
+
+	for tag, target := range tags {
+		universal, upos := universalTags[target], uposTags[target]
+
+		if tag == "" || (!universal && !upos) {
+			return nil, errors.New("Tag `" + tag + "` is mapped to `" + target + "`, which is neither Universal Tagset nor UPOS tag!")
+		}
+
+		m.universal[tag], m.upos[tag] = target, target
+
+		if !universal {
+			m.universal[tag] = uposUniversal[target]
+		}
+
+		if !upos {
+			m.upos[tag] = universalUPOS[target]
+		}
+	}
+
+	return m, nil
+}
+
+// builtinMapping creates the built-in mapping from UPOS tags (the Universal Tagset ones are derived) with the features and tags normaliser
+func builtinMapping(upos map[string]string, features map[string]string, normalize func(tag string) string) *mapping {
+	m, err := newMapping(upos)
+
+	// the built-in data is broken, there's no way to recover
+	if err != nil {
+		panic(err)
+	}
+
+	m.features, m.normalize, m.builtin = features, normalize, true
+
+	return m
+}
+
+// RegisterFile reads the mapping file (see ReadMapping) and registers it for the tagset name/language (see Register).
+func RegisterFile(name string, lang string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	tags, err := ReadMapping(file)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return Register(name, lang, tags)
+}
+
+// ReadMapping reads the tagset mapping either as JSON object {"NN": "NOUN", "NNS": "NOUN"}, or as TSV lines "NN<TAB>NOUN"
+// (blank lines and lines starting with "#" are ignored), the format is detected from the first non-blank character.
+func ReadMapping(r io.Reader) (map[string]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string)
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		if err := json.Unmarshal(trimmed, &tags); err != nil {
+			return nil, err
+		}
+
+		return tags, nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: expected \"tag<TAB>universal tag\", got %q", lineNum, line)
+		}
+
+		tags[strings.TrimSpace(fields[0])] = strings.TrimSpace(fields[1])
+	}
+
+	return tags, scanner.Err()
+}
+
+// List lists the registered tagsets (built-in and registered at runtime) sorted by name and language.
+func List() []Info {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	infos := make([]Info, 0, len(registry))
+
+	for key, m := range registry {
+		i := strings.LastIndex(key, "_")
+		infos = append(infos, Info{Name: key[:i], Lang: key[i+1:], Tags: len(m.universal), Builtin: m.builtin, Positional: m.positional != nil})
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].Name != infos[j].Name {
+			return infos[i].Name < infos[j].Name
+		}
+
+		return infos[i].Lang < infos[j].Lang
+	})
+
+	return infos
+}
+
+// Registered checks if the tagset name/language (BCP 47, only the base language is used) is registered (see Register).
+func Registered(name string, lang string) bool {
+	base, _ := language.Make(lang).Base()
+	_, ok := lookupMapping(name, base.String())
+
+	return ok
+}
+
+// toUniversal maps the tag to Universal Tagset (decoding the positional tags and normalising the unknown ones)
+func (m *mapping) toUniversal(tag string) (string, bool) {
+	if m.positional != nil {
+		decoded, ok := m.positional.decode(tag)
+
+		return decoded.Universal, ok
+	}
+
+	pos, ok := m.universal[tag]
+
+	if !ok && m.normalize != nil {
+		pos, ok = m.universal[m.normalize(tag)]
+	}
+
+	return pos, ok
+}
+
+// toUPOS maps the tag to UPOS (decoding the positional tags and normalising the unknown ones), the word rules aren't applied
+func (m *mapping) toUPOS(tag string) (string, bool) {
+	if m.positional != nil {
+		decoded, ok := m.positional.decode(tag)
+
+		return decoded.UPOS, ok
+	}
+
+	pos, ok := m.upos[tag]
+
+	if !ok && m.normalize != nil {
+		pos, ok = m.upos[m.normalize(tag)]
+	}
+
+	return pos, ok
+}
+
+// lookupMapping returns the registered mapping of the tagset name/base language
+func lookupMapping(tagsetName string, languageTag string) (*mapping, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	m, ok := registry[tagsetName+"_"+languageTag]
+
+	return m, ok
+}
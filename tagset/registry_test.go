@@ -0,0 +1,102 @@
+package tagset_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/smileart/lemmingo/tagset"
+)
+
+func TestRegister(t *testing.T) {
+	mapping := map[string]string{"NC": "NOUN", "NP": "PROPN", "VA": "AUX", "CC": "CONJ", "Fp": "."}
+
+	if err := tagset.Register("eagles-test", "es-ES", mapping); err != nil {
+		t.Fatal(err)
+	}
+
+	mapPos := tagset.MapPos("eagles-test", "es")
+	mapUPOS := tagset.MapTo(tagset.UPOS, "eagles-test", "es-MX")
+
+	testCases := []MapTestCase{
+		{tag: "NC", pos: "NOUN", ok: true},
+		{tag: "NP", pos: "NOUN", ok: true},
+		{tag: "VA", pos: "VERB", ok: true},
+		{tag: "CC", pos: "CONJ", ok: true},
+		{tag: "Fp", pos: ".", ok: true},
+		{tag: "ZZ", pos: "", ok: false},
+	}
+
+	for _, tc := range testCases {
+		if pos, ok := mapPos(tc.tag); pos != tc.pos || ok != tc.ok {
+			t.Errorf("For %s we've got: '%s' (%v), expected: '%s' (%v)", tc.tag, pos, ok, tc.pos, tc.ok)
+		}
+	}
+
+	if pos, _ := mapUPOS("", "NP"); pos != "PROPN" {
+		t.Errorf("For NP we've got: '%s' UPOS, expected: 'PROPN'", pos)
+	}
+
+	if pos, _ := mapUPOS("", "CC"); pos != "CCONJ" {
+		t.Errorf("For CC we've got: '%s' UPOS, expected: 'CCONJ'", pos)
+	}
+
+	if err := tagset.Register("eagles-test", "es", mapping); err == nil {
+		t.Errorf("The method was supposed to return an error for already registered tagset!")
+	}
+
+	if err := tagset.Register("penn", "en", mapping); err == nil {
+		t.Errorf("The method was supposed to return an error for built-in tagset!")
+	}
+
+	if err := tagset.Register("wrong-test", "en", map[string]string{"NN": "NOUNS"}); err == nil {
+		t.Errorf("The method was supposed to return an error for unknown universal tag!")
+	}
+
+	var found bool
+
+	for _, info := range tagset.List() {
+		if info.Name == "eagles-test" && info.Lang == "es" && info.Tags == len(mapping) && !info.Builtin {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Errorf("Registered tagset wasn't listed: %+v", tagset.List())
+	}
+}
+
+func TestReadMapping(t *testing.T) {
+	for _, data := range []string{
+		"# Brown-like tags\nNN\tNOUN\n\nVBD\tVERB\n",
+		`{"NN": "NOUN", "VBD": "VERB"}`,
+	} {
+		mapping, err := tagset.ReadMapping(strings.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(mapping) != 2 || mapping["NN"] != "NOUN" || mapping["VBD"] != "VERB" {
+			t.Errorf("We've got: %v, expected: map[NN:NOUN VBD:VERB]", mapping)
+		}
+	}
+
+	if _, err := tagset.ReadMapping(strings.NewReader("NN NOUN\n")); err == nil {
+		t.Errorf("The method was supposed to return an error for malformed TSV line!")
+	}
+
+	path := filepath.Join(t.TempDir(), "mapping.tsv")
+
+	if err := os.WriteFile(path, []byte("nn\tNOUN\nvb\tVERB\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tagset.RegisterFile("file-test", "en", path); err != nil {
+		t.Fatal(err)
+	}
+
+	if pos, ok := tagset.MapPos("file-test", "en")("vb"); pos != "VERB" || !ok {
+		t.Errorf("For vb we've got: '%s' (%v), expected: 'VERB' (true)", pos, ok)
+	}
+}
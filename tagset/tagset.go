@@ -3,26 +3,46 @@ package tagset
 
 import (
 	"errors"
+	"strings"
 
 	"golang.org/x/text/language"
 )
 
-// tagsetMapping names, and maps supported tagsets and returns the one for the languageTag provided.
-func tagsetMapping(tagsetName string, languageTag string) (map[string]string, error) {
-	closureMap := map[string]map[string]string{
-		"penn_en":     pennEn,     // Penn Treebank Project, EN
-		"freeling_en": freelingEn, // FreeLing Project, EN
-		"wordnet_en":  wordnetEn,  // WordNet Project, EN
+// Target tagsets of the mappings
+const (
+	Universal = "universal" // A Universal Part-of-Speech Tagset (12 tags: NOUN, VERB, ADJ, ADV, PRON, DET, ADP, NUM, CONJ, PRT, ".", X)
+	UPOS      = "upos"      // Universal Dependencies v2 UPOS (17 tags, adds AUX, CCONJ, SCONJ, PROPN, PART, INTJ, PUNCT and SYM)
+)
+
+// wordRule maps the tags of the listed words (lowercased forms) to another UPOS tag, e.g. "VBZ" of "is" to "AUX"
+type wordRule struct {
+	tags  []string
+	words []string
+	upos  string
+}
+
+// tagsetMapping returns the Universal Tagset mapping of the registered tagset for the languageTag provided (see Register).
+func tagsetMapping(tagsetName string, languageTag string) (func(string) (string, bool), error) {
+	mappingKey := tagsetName + "_" + languageTag
+	m, ok := lookupMapping(tagsetName, languageTag)
+
+	if !ok {
+		return nil, errors.New("Tagset mapping for `" + mappingKey + "` was not found!")
 	}
 
+	return m.toUniversal, nil
+}
+
+// uposMapping returns the UPOS mapping of the registered tagset for the languageTag provided with its word-aware rules.
+func uposMapping(tagsetName string, languageTag string) (func(string) (string, bool), []wordRule, error) {
 	mappingKey := tagsetName + "_" + languageTag
-	mapping, ok := closureMap[mappingKey]
+	m, ok := lookupMapping(tagsetName, languageTag)
 
 	if !ok {
-		return mapping, errors.New("Tagset mapping for `" + mappingKey + "` was not found!")
+		return nil, nil, errors.New("UPOS tagset mapping for `" + mappingKey + "` was not found!")
 	}
 
-	return mapping, nil
+	return m.toUPOS, m.rules, nil
 }
 
 // MapPos gets the mapping for the tagsetName/languageTag provided and returns a function to access PoS mappings.
@@ -39,8 +59,97 @@ func MapPos(tagsetName string, languageTag string) func(posTag string) (string,
 	}
 
 	return func(posTag string) (string, bool) {
-		val, ok := tagset[posTag]
+		return tagset(posTag)
+	}
+}
+
+// MapTo gets the mapping for the tagsetName/languageTag provided to the target tagset (Universal or UPOS)
+// and returns a function to access word-aware PoS mappings.
+//
+// UPOS mapping applies the word rules of the tagset when the word is provided (e.g. Penn "VBZ" of "is" and "MD" map to "AUX",
+// "IN" of "because" maps to "SCONJ", "NNP" maps to "PROPN"), Universal mapping ignores the word (see MapPos).
+func MapTo(target string, tagsetName string, languageTag string) func(word string, posTag string) (string, bool) {
+	if target == Universal {
+		mapPos := MapPos(tagsetName, languageTag)
 
-		return val, ok
+		return func(word string, posTag string) (string, bool) {
+			return mapPos(posTag)
+		}
 	}
+
+	if target != UPOS {
+		panic(errors.New("Target tagset `" + target + "` is not supported!"))
+	}
+
+	base, _ := language.Make(languageTag).Base()
+
+	tagset, rules, err := uposMapping(tagsetName, base.String())
+
+	// if we wanted a certain mapping, but can't load one there's no way to recover
+	if err != nil {
+		panic(err)
+	}
+
+	// tag -> word -> UPOS
+	words := make(map[string]map[string]string)
+
+	for _, rule := range rules {
+		for _, tag := range rule.tags {
+			if words[tag] == nil {
+				words[tag] = make(map[string]string)
+			}
+
+			for _, word := range rule.words {
+				words[tag][word] = rule.upos
+			}
+		}
+	}
+
+	return func(word string, posTag string) (string, bool) {
+		if val, ok := words[posTag][strings.ToLower(word)]; ok {
+			return val, true
+		}
+
+		return tagset(posTag)
+	}
+}
+
+// normalizeBrown strips Brown tag modifiers: "-TL" (title), "-HL" (headline), "-NC" (cited word) suffixes, "*" negation
+// (e.g. "DOD*" of "didn't"), the contracted parts (e.g. "PPS+BEZ" of "it's" is mapped by its first part as in freelingEn),
+// and maps foreign words of any tag ("FW-" prefix) to "FW"
+func normalizeBrown(tag string) string {
+	if strings.HasPrefix(tag, "FW-") {
+		return "FW"
+	}
+
+	if i := strings.Index(tag, "+"); i > 0 {
+		tag = tag[:i]
+	}
+
+	for _, suffix := range []string{"-TL", "-HL", "-NC"} {
+		tag = strings.TrimSuffix(tag, suffix)
+	}
+
+	if len(tag) > 1 {
+		tag = strings.TrimSuffix(tag, "*")
+	}
+
+	return tag
+}
+
+// normalizeCLAWS strips CLAWS ditto tag digits of the multi-word units (e.g. "II21" to "II", "NN121" to "NN1")
+// and the uncertainty markers ("@", "%")
+func normalizeCLAWS(tag string) string {
+	tag = strings.TrimRight(tag, "@%")
+
+	if n := len(tag); n > 3 && isDigit(tag[n-1]) && isDigit(tag[n-2]) {
+		tag = tag[:n-2]
+	}
+
+	return tag
+}
+
+// isDigit checks if the character is an ASCII digit
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
 }
@@ -129,3 +129,742 @@ var wordnetEn = map[string]string{
 	"s": "ADJ",
 	"r": "ADV",
 }
+
+// Penn Treebank Project - EN to Universal Dependencies v2 UPOS, following UD English Web Treebank conversion
+// REF: https://universaldependencies.org/u/pos/
+// REF: https://universaldependencies.org/tagset-conversion/en-penn-uposf.html
+var pennEnUPOS = map[string]string{
+	"CC":   "CCONJ",
+	"CD":   "NUM",
+	"DT":   "DET",
+	"EX":   "PRON",
+	"FW":   "X",
+	"IN":   "ADP", // SCONJ for subordinating conjunctions, see enUPOSRules
+	"JJ":   "ADJ",
+	"JJR":  "ADJ",
+	"JJS":  "ADJ",
+	"LS":   "X",
+	"MD":   "AUX",
+	"NN":   "NOUN",
+	"NNS":  "NOUN",
+	"NNP":  "PROPN",
+	"NNPS": "PROPN",
+	"PDT":  "DET",
+	"POS":  "PART",
+	"PRP":  "PRON",
+	"PRP$": "PRON",
+	"RB":   "ADV",
+	"RBR":  "ADV",
+	"RBS":  "ADV",
+	"RP":   "ADP",
+	"SYM":  "SYM",
+	"TO":   "PART",
+	"UH":   "INTJ",
+	"VB":   "VERB", // AUX for the forms of "be" and "have", see enUPOSRules
+	"VBZ":  "VERB",
+	"VBP":  "VERB",
+	"VBD":  "VERB",
+	"VBN":  "VERB",
+	"VBG":  "VERB",
+	"WDT":  "DET",
+	"WP":   "PRON",
+	"WP$":  "PRON",
+	"WRB":  "ADV",
+	"(":    "PUNCT",
+	")":    "PUNCT",
+	",":    "PUNCT",
+	":":    "PUNCT",
+	".":    "PUNCT",
+	"''":   "PUNCT",
+	"``":   "PUNCT",
+	"#":    "SYM",
+	"$":    "SYM",
+}
+
+// FreeLing, an open source language analysis tool suite - EN to Universal Dependencies v2 UPOS,
+// contractions are mapped by their first part as in freelingEn
+var freelingEnUPOS = map[string]string{
+	"CC":            "CCONJ",
+	"DT":            "DET",
+	"DT+MD":         "DET",
+	"DT+VB":         "DET",
+	"DT+VBD/MD":     "DET",
+	"EX":            "PRON",
+	"IN":            "ADP",
+	"JJ":            "ADJ",
+	"JJR":           "ADJ",
+	"JJS":           "ADJ",
+	"MD":            "AUX",
+	"MD+RB":         "AUX",
+	"MD+RB+VBP":     "AUX",
+	"MD+VB":         "AUX",
+	"NN":            "NOUN",
+	"NNS":           "NOUN",
+	"POS":           "PART",
+	"PRP":           "PRON",
+	"PRP$":          "PRON",
+	"PRP+DT":        "PRON",
+	"PRP+MD":        "PRON",
+	"PRP+MD+RB":     "PRON",
+	"PRP+MD+RB+VBP": "PRON",
+	"PRP+MD+VBP":    "PRON",
+	"PRP+VB":        "PRON",
+	"PRP+VBD/MD":    "PRON",
+	"PRP+VBP":       "PRON",
+	"RB":            "ADV",
+	"RB+MD":         "ADV",
+	"RB+VBD/MD":     "ADV",
+	"RB+VBZ":        "ADV",
+	"RBR":           "ADV",
+	"RBS":           "ADV",
+	"RP":            "ADP",
+	"TO":            "PART",
+	"UH":            "INTJ",
+	"VB":            "VERB",
+	"VB+PRP":        "VERB",
+	"VB+RB":         "VERB",
+	"VBD":           "VERB",
+	"VBD+RB":        "VERB",
+	"VBG":           "VERB",
+	"VBN":           "VERB",
+	"VBP":           "VERB",
+	"VBP+RB":        "VERB",
+	"VBZ":           "VERB",
+	"VBZ+RB":        "VERB",
+	"WDT":           "DET",
+	"WP":            "PRON",
+	"WP$":           "PRON",
+	"WP+MD":         "PRON",
+	"WP+MD+VBP":     "PRON",
+	"WP+VB":         "PRON",
+	"WP+VBD/MD":     "PRON",
+	"WP+VBP":        "PRON",
+	"WRB":           "ADV",
+	"WRB+VB":        "ADV",
+}
+
+// WordNet Project - EN to Universal Dependencies v2 UPOS
+var wordnetEnUPOS = map[string]string{
+	"n": "NOUN",
+	"v": "VERB",
+	"a": "ADJ",
+	"s": "ADJ",
+	"r": "ADV",
+}
+
+// enUPOSRules are the word-aware UPOS rules of Penn-like English tagsets (Penn Treebank Project, FreeLing),
+// prepositions which could introduce clauses as well (e.g. "after", "since") are kept ADP
+// REF: https://universaldependencies.org/en/pos/AUX_.html
+// REF: https://universaldependencies.org/en/pos/SCONJ.html
+var enUPOSRules = []wordRule{
+	{
+		tags: []string{"VB", "VBD", "VBG", "VBN", "VBP", "VBZ", "VB+RB", "VBD+RB", "VBP+RB", "VBZ+RB"},
+		words: []string{
+			"be", "am", "is", "are", "was", "were", "been", "being", "'m", "'re", "'s", "ai",
+			"isn't", "aren't", "wasn't", "weren't", "ain't",
+			"have", "has", "had", "having", "'ve", "'d",
+			"hasn't", "haven't", "hadn't",
+		},
+		upos: "AUX",
+	},
+	{
+		tags: []string{"IN"},
+		words: []string{
+			"although", "because", "if", "lest", "that", "though", "unless", "whereas", "whether", "while", "whilst",
+		},
+		upos: "SCONJ",
+	},
+}
+
+// enFeatures are the UD features encoded by Penn-like English tags (Penn Treebank Project, FreeLing),
+// the features depending on the word (e.g. "Person" of "PRP", "Definite" of "DT") are left out
+// REF: https://universaldependencies.org/en/feat/
+// REF: https://universaldependencies.org/tagset-conversion/en-penn-uposf.html
+var enFeatures = map[string]string{
+	"CD":   "NumType=Card",
+	"FW":   "Foreign=Yes",
+	"JJ":   "Degree=Pos",
+	"JJR":  "Degree=Cmp",
+	"JJS":  "Degree=Sup",
+	"MD":   "VerbForm=Fin",
+	"NN":   "Number=Sing",
+	"NNS":  "Number=Plur",
+	"NNP":  "Number=Sing",
+	"NNPS": "Number=Plur",
+	"PRP":  "PronType=Prs",
+	"PRP$": "Poss=Yes|PronType=Prs",
+	"RBR":  "Degree=Cmp",
+	"RBS":  "Degree=Sup",
+	"VB":   "VerbForm=Inf",
+	"VBD":  "Tense=Past|VerbForm=Fin",
+	"VBG":  "VerbForm=Ger",
+	"VBN":  "Tense=Past|VerbForm=Part",
+	"VBP":  "Tense=Pres|VerbForm=Fin",
+	"VBZ":  "Number=Sing|Person=3|Tense=Pres|VerbForm=Fin",
+	"WDT":  "PronType=Int,Rel",
+	"WP":   "PronType=Int,Rel",
+	"WP$":  "Poss=Yes|PronType=Int,Rel",
+	"WRB":  "PronType=Int,Rel",
+}
+
+// Brown Corpus - EN to Universal Dependencies v2 UPOS (Universal Tagset tags are derived), the tags as in NLTK corpus,
+// the modifiers (e.g. "NN-TL", "FW-IN", "DOD*", "PPS+BEZ") are normalised by normalizeBrown
+// REF: http://korpus.uib.no/icame/manuals/BROWN/INDEX.HTM
+// REF: https://www.nltk.org/book/ch05.html
+// REF: https://github.com/slavpetrov/universal-pos-tags/blob/master/en-brown.map
+var brownEnUPOS = map[string]string{
+	"ABL":  "ADV",   // pre-qualifier - quite, rather
+	"ABN":  "DET",   // pre-quantifier - half, all
+	"ABX":  "DET",   // pre-quantifier - both
+	"AP":   "ADJ",   // post-determiner - many, several, next
+	"AT":   "DET",   // article - a, the, no
+	"BE":   "AUX",   // be
+	"BED":  "AUX",   // were
+	"BEDZ": "AUX",   // was
+	"BEG":  "AUX",   // being
+	"BEM":  "AUX",   // am
+	"BEN":  "AUX",   // been
+	"BER":  "AUX",   // are, art
+	"BEZ":  "AUX",   // is
+	"CC":   "CCONJ", // coordinating conjunction - and, or
+	"CD":   "NUM",   // cardinal numeral - one, two, 2
+	"CS":   "SCONJ", // subordinating conjunction - if, although
+	"DO":   "VERB",  // do
+	"DOD":  "VERB",  // did
+	"DOZ":  "VERB",  // does
+	"DT":   "DET",   // singular determiner - this, that
+	"DTI":  "DET",   // singular or plural determiner/quantifier - some, any
+	"DTS":  "DET",   // plural determiner - these, those
+	"DTX":  "CCONJ", // determiner/double conjunction - either
+	"EX":   "PRON",  // existential there
+	"FW":   "X",     // foreign word
+	"HV":   "AUX",   // have
+	"HVD":  "AUX",   // had (past tense)
+	"HVG":  "AUX",   // having
+	"HVN":  "AUX",   // had (past participle)
+	"HVZ":  "AUX",   // has
+	"IN":   "ADP",   // preposition - in, of
+	"JJ":   "ADJ",   // adjective
+	"JJR":  "ADJ",   // comparative adjective
+	"JJS":  "ADJ",   // semantically superlative adjective - chief, top
+	"JJT":  "ADJ",   // morphologically superlative adjective - biggest
+	"MD":   "AUX",   // modal auxiliary - can, should, will
+	"NN":   "NOUN",  // singular or mass noun
+	"NN$":  "NOUN",  // possessive singular noun
+	"NNS":  "NOUN",  // plural noun
+	"NNS$": "NOUN",  // possessive plural noun
+	"NP":   "PROPN", // proper noun
+	"NP$":  "PROPN", // possessive proper noun
+	"NPS":  "PROPN", // plural proper noun
+	"NPS$": "PROPN", // possessive plural proper noun
+	"NR":   "NOUN",  // adverbial noun - home, today, west
+	"NR$":  "NOUN",  // possessive adverbial noun
+	"NRS":  "NOUN",  // plural adverbial noun
+	"OD":   "ADJ",   // ordinal numeral - first, 2nd
+	"PN":   "PRON",  // nominal pronoun - everybody, nothing
+	"PN$":  "PRON",  // possessive nominal pronoun
+	"PP$":  "PRON",  // possessive personal pronoun - my, our
+	"PP$$": "PRON",  // second (nominal) possessive pronoun - mine, ours
+	"PPL":  "PRON",  // singular reflexive/intensive personal pronoun - myself
+	"PPLS": "PRON",  // plural reflexive/intensive personal pronoun - ourselves
+	"PPO":  "PRON",  // objective personal pronoun - me, him, it, them
+	"PPS":  "PRON",  // 3rd singular nominative pronoun - he, she, it, one
+	"PPSS": "PRON",  // other nominative personal pronoun - I, we, they, you
+	"QL":   "ADV",   // qualifier - very, fairly
+	"QLP":  "ADV",   // post-qualifier - enough, indeed
+	"RB":   "ADV",   // adverb
+	"RBR":  "ADV",   // comparative adverb
+	"RBT":  "ADV",   // superlative adverb
+	"RN":   "ADV",   // nominal adverb - here, then, indoors
+	"RP":   "ADP",   // adverb/particle - about, off, up
+	"TO":   "PART",  // infinitive marker to
+	"UH":   "INTJ",  // interjection, exclamation
+	"VB":   "VERB",  // verb, base form
+	"VBD":  "VERB",  // verb, past tense
+	"VBG":  "VERB",  // verb, present participle/gerund
+	"VBN":  "VERB",  // verb, past participle
+	"VBZ":  "VERB",  // verb, 3rd singular present
+	"WDT":  "DET",   // wh- determiner - what, which
+	"WP$":  "PRON",  // possessive wh- pronoun - whose
+	"WPO":  "PRON",  // objective wh- pronoun - whom, which, that
+	"WPS":  "PRON",  // nominative wh- pronoun - who, which, that
+	"WQL":  "ADV",   // wh- qualifier - how
+	"WRB":  "ADV",   // wh- adverb - how, where, when
+	"NIL":  "X",     // no tag
+	"*":    "PART",  // not, n't
+	".":    "PUNCT", // sentence closer
+	",":    "PUNCT", // comma
+	":":    "PUNCT", // colon
+	"--":   "PUNCT", // dash
+	"(":    "PUNCT", // left parenthesis
+	")":    "PUNCT", // right parenthesis
+	"''":   "PUNCT", // closing quotation mark
+	"``":   "PUNCT", // opening quotation mark
+	"'":    "PUNCT", // apostrophe
+}
+
+// CLAWS7 (the tagset of BNC Sampler, C7) - EN to Universal Dependencies v2 UPOS (Universal Tagset tags are derived),
+// ditto tags of multi-word units (e.g. "II21", "II22" of "because of") are normalised by normalizeCLAWS
+// REF: https://ucrel.lancs.ac.uk/claws7tags.html
+// REF: https://ucrel.lancs.ac.uk/bnc2sampler/sampler.htm
+var claws7EnUPOS = map[string]string{
+	"APPGE": "PRON",  // possessive pronoun, pre-nominal - my, your, our
+	"AT":    "DET",   // article - the, no
+	"AT1":   "DET",   // singular article - a, an, every
+	"BCL":   "SCONJ", // before-clause marker - in order (that)
+	"CC":    "CCONJ", // coordinating conjunction - and, or
+	"CCB":   "CCONJ", // adversative coordinating conjunction - but
+	"CS":    "SCONJ", // subordinating conjunction - if, because, unless
+	"CSA":   "SCONJ", // as (as conjunction)
+	"CSN":   "SCONJ", // than (as conjunction)
+	"CST":   "SCONJ", // that (as conjunction)
+	"CSW":   "SCONJ", // whether (as conjunction)
+	"DA":    "DET",   // after-determiner or post-determiner - such, former, same
+	"DA1":   "DET",   // singular after-determiner - little, much
+	"DA2":   "DET",   // plural after-determiner - few, several, many
+	"DAR":   "DET",   // comparative after-determiner - more, less, fewer
+	"DAT":   "DET",   // superlative after-determiner - most, least, fewest
+	"DB":    "DET",   // before determiner or pre-determiner - all, half
+	"DB2":   "DET",   // plural before-determiner - both
+	"DD":    "DET",   // determiner - any, some
+	"DD1":   "DET",   // singular determiner - this, that, another
+	"DD2":   "DET",   // plural determiner - these, those
+	"DDQ":   "DET",   // wh-determiner - which, what
+	"DDQGE": "DET",   // wh-determiner, genitive - whose
+	"DDQV":  "DET",   // wh-ever determiner - whichever, whatever
+	"EX":    "PRON",  // existential there
+	"FO":    "SYM",   // formula
+	"FU":    "X",     // unclassified word
+	"FW":    "X",     // foreign word
+	"GE":    "PART",  // germanic genitive marker - ' or 's
+	"IF":    "ADP",   // for (as preposition)
+	"II":    "ADP",   // general preposition
+	"IO":    "ADP",   // of (as preposition)
+	"IW":    "ADP",   // with, without (as prepositions)
+	"JJ":    "ADJ",   // general adjective
+	"JJR":   "ADJ",   // general comparative adjective - older, better
+	"JJT":   "ADJ",   // general superlative adjective - oldest, best
+	"JK":    "ADJ",   // catenative adjective - able in "be able to"
+	"MC":    "NUM",   // cardinal number, neutral for number - two, three
+	"MC1":   "NUM",   // singular cardinal number - one
+	"MC2":   "NUM",   // plural cardinal number - sixes, sevens
+	"MCGE":  "NUM",   // genitive cardinal number, neutral for number - two's
+	"MCMC":  "NUM",   // hyphenated number - 40-50, 1770-1827
+	"MD":    "ADJ",   // ordinal number - first, second, next, last
+	"MF":    "NUM",   // fraction, neutral for number - quarters, two-thirds
+	"ND1":   "NOUN",  // singular noun of direction - north, southeast
+	"NN":    "NOUN",  // common noun, neutral for number - sheep, cod
+	"NN1":   "NOUN",  // singular common noun - book, girl
+	"NN2":   "NOUN",  // plural common noun - books, girls
+	"NNA":   "NOUN",  // following noun of title - M.A.
+	"NNB":   "NOUN",  // preceding noun of title - Mr., Prof.
+	"NNL1":  "NOUN",  // singular locative noun - Island, Street
+	"NNL2":  "NOUN",  // plural locative noun - Islands, Streets
+	"NNO":   "NUM",   // numeral noun, neutral for number - dozen, hundred
+	"NNO2":  "NUM",   // numeral noun, plural - hundreds, thousands
+	"NNT1":  "NOUN",  // temporal noun, singular - day, week, year
+	"NNT2":  "NOUN",  // temporal noun, plural - days, weeks, years
+	"NNU":   "NOUN",  // unit of measurement, neutral for number - in, cc
+	"NNU1":  "NOUN",  // singular unit of measurement - inch, centimetre
+	"NNU2":  "NOUN",  // plural unit of measurement - ins., feet
+	"NP":    "PROPN", // proper noun, neutral for number - IBM, Andes
+	"NP1":   "PROPN", // singular proper noun - London, Jane, Frederick
+	"NP2":   "PROPN", // plural proper noun - Browns, Reagans, Koreas
+	"NPD1":  "PROPN", // singular weekday noun - Sunday
+	"NPD2":  "PROPN", // plural weekday noun - Sundays
+	"NPM1":  "PROPN", // singular month noun - October
+	"NPM2":  "PROPN", // plural month noun - Octobers
+	"PN":    "PRON",  // indefinite pronoun, neutral for number - none
+	"PN1":   "PRON",  // indefinite pronoun, singular - anyone, everything, nobody, one
+	"PNQO":  "PRON",  // objective wh-pronoun - whom
+	"PNQS":  "PRON",  // subjective wh-pronoun - who
+	"PNQV":  "PRON",  // wh-ever pronoun - whoever
+	"PNX1":  "PRON",  // reflexive indefinite pronoun - oneself
+	"PPGE":  "PRON",  // nominal possessive personal pronoun - mine, yours
+	"PPH1":  "PRON",  // 3rd person sing. neuter personal pronoun - it
+	"PPHO1": "PRON",  // 3rd person sing. objective personal pronoun - him, her
+	"PPHO2": "PRON",  // 3rd person plural objective personal pronoun - them
+	"PPHS1": "PRON",  // 3rd person sing. subjective personal pronoun - he, she
+	"PPHS2": "PRON",  // 3rd person plural subjective personal pronoun - they
+	"PPIO1": "PRON",  // 1st person sing. objective personal pronoun - me
+	"PPIO2": "PRON",  // 1st person plural objective personal pronoun - us
+	"PPIS1": "PRON",  // 1st person sing. subjective personal pronoun - I
+	"PPIS2": "PRON",  // 1st person plural subjective personal pronoun - we
+	"PPX1":  "PRON",  // singular reflexive personal pronoun - yourself, itself
+	"PPX2":  "PRON",  // plural reflexive personal pronoun - yourselves, themselves
+	"PPY":   "PRON",  // 2nd person personal pronoun - you
+	"RA":    "ADV",   // adverb, after nominal head - else, galore
+	"REX":   "ADV",   // adverb introducing appositional constructions - namely, e.g.
+	"RG":    "ADV",   // degree adverb - very, so, too
+	"RGQ":   "ADV",   // wh- degree adverb - how
+	"RGQV":  "ADV",   // wh-ever degree adverb - however
+	"RGR":   "ADV",   // comparative degree adverb - more, less
+	"RGT":   "ADV",   // superlative degree adverb - most, least
+	"RL":    "ADV",   // locative adverb - alongside, forward
+	"RP":    "ADP",   // prep. adverb, particle - about, in
+	"RPK":   "PART",  // prep. adv., catenative - about in "be about to"
+	"RR":    "ADV",   // general adverb
+	"RRQ":   "ADV",   // wh- general adverb - where, when, why, how
+	"RRQV":  "ADV",   // wh-ever general adverb - wherever, whenever
+	"RRR":   "ADV",   // comparative general adverb - better, longer
+	"RRT":   "ADV",   // superlative general adverb - best, longest
+	"RT":    "ADV",   // quasi-nominal adverb of time - now, tomorrow
+	"TO":    "PART",  // infinitive marker - to
+	"UH":    "INTJ",  // interjection - oh, yes, um
+	"VB0":   "AUX",   // be, base form
+	"VBDR":  "AUX",   // were
+	"VBDZ":  "AUX",   // was
+	"VBG":   "AUX",   // being
+	"VBI":   "AUX",   // be, infinitive
+	"VBM":   "AUX",   // am
+	"VBN":   "AUX",   // been
+	"VBR":   "AUX",   // are
+	"VBZ":   "AUX",   // is
+	"VD0":   "VERB",  // do, base form
+	"VDD":   "VERB",  // did
+	"VDG":   "VERB",  // doing
+	"VDI":   "VERB",  // do, infinitive
+	"VDN":   "VERB",  // done
+	"VDZ":   "VERB",  // does
+	"VH0":   "AUX",   // have, base form
+	"VHD":   "AUX",   // had (past tense)
+	"VHG":   "AUX",   // having
+	"VHI":   "AUX",   // have, infinitive
+	"VHN":   "AUX",   // had (past participle)
+	"VHZ":   "AUX",   // has
+	"VM":    "AUX",   // modal auxiliary - can, will, would
+	"VMK":   "AUX",   // modal catenative - ought, used
+	"VV0":   "VERB",  // base form of lexical verb - give, work
+	"VVD":   "VERB",  // past tense of lexical verb - gave, worked
+	"VVG":   "VERB",  // -ing participle of lexical verb - giving, working
+	"VVGK":  "VERB",  // -ing participle catenative - going in "be going to"
+	"VVI":   "VERB",  // infinitive - to give, it will work
+	"VVN":   "VERB",  // past participle of lexical verb - given, worked
+	"VVNK":  "VERB",  // past participle catenative - bound in "be bound to"
+	"VVZ":   "VERB",  // -s form of lexical verb - gives, works
+	"XX":    "PART",  // not, n't
+	"ZZ1":   "NOUN",  // singular letter of the alphabet - A, b
+	"ZZ2":   "NOUN",  // plural letter of the alphabet - A's, b's
+	"YBL":   "PUNCT", // left bracket
+	"YBR":   "PUNCT", // right bracket
+	"YCOL":  "PUNCT", // colon
+	"YCOM":  "PUNCT", // comma
+	"YDSH":  "PUNCT", // dash
+	"YEX":   "PUNCT", // exclamation mark
+	"YLIP":  "PUNCT", // ellipsis
+	"YQUE":  "PUNCT", // question mark
+	"YQUO":  "PUNCT", // quotation mark
+	"YSCOL": "PUNCT", // semicolon
+	"YSTP":  "PUNCT", // full stop
+}
+
+// Stuttgart-Tübingen Tagset (STTS, as in TIGER and TüBa-D/Z corpora) - DE to Universal Dependencies v2 UPOS
+// (Universal Tagset tags are derived), following UD German conversion
+// REF: https://www.ims.uni-stuttgart.de/documents/ressourcen/lexika/tagsets/stts-1999.pdf
+// REF: https://universaldependencies.org/tagset-conversion/de-stts-uposf.html
+// REF: https://github.com/slavpetrov/universal-pos-tags/blob/master/de-tiger.map
+var sttsDeUPOS = map[string]string{
+	"ADJA":    "ADJ",   // attributive adjective - [das] große [Haus]
+	"ADJD":    "ADJ",   // adverbial or predicative adjective - [er fährt] schnell
+	"ADV":     "ADV",   // adverb - schon, bald, doch
+	"APPR":    "ADP",   // preposition, left circumposition - in [der Stadt]
+	"APPRART": "ADP",   // preposition with article - im [Haus], zur [Sache]
+	"APPO":    "ADP",   // postposition - [ihm] zufolge
+	"APZR":    "ADP",   // right circumposition - [von jetzt] an
+	"ART":     "DET",   // definite or indefinite article - der, die, das, ein
+	"CARD":    "NUM",   // cardinal number - zwei [Männer]
+	"FM":      "X",     // foreign language material - [Er hat das mit ``] A big fish ['' übersetzt]
+	"ITJ":     "INTJ",  // interjection - mhm, ach, tja
+	"KOUI":    "SCONJ", // subordinating conjunction with "zu" and infinitive - um [zu leben]
+	"KOUS":    "SCONJ", // subordinating conjunction with sentence - weil, dass, damit
+	"KON":     "CCONJ", // coordinating conjunction - und, oder, aber
+	"KOKOM":   "CCONJ", // comparative conjunction - als, wie
+	"NN":      "NOUN",  // common noun - Tisch, Herr
+	"NE":      "PROPN", // proper noun - Hans, Hamburg
+	"NNE":     "PROPN", // proper noun used as common noun (TIGER)
+	"PDS":     "PRON",  // substituting demonstrative pronoun - dieser, jener
+	"PDAT":    "DET",   // attributive demonstrative pronoun - jener [Mensch]
+	"PIS":     "PRON",  // substituting indefinite pronoun - keiner, viele
+	"PIAT":    "DET",   // attributive indefinite pronoun without determiner - kein [Mensch]
+	"PIDAT":   "DET",   // attributive indefinite pronoun with determiner - [ein] wenig [Wasser]
+	"PPER":    "PRON",  // irreflexive personal pronoun - ich, er, ihm
+	"PPOSS":   "PRON",  // substituting possessive pronoun - meins, deiner
+	"PPOSAT":  "DET",   // attributive possessive pronoun - mein [Buch]
+	"PRELS":   "PRON",  // substituting relative pronoun - [der Hund ,] der
+	"PRELAT":  "DET",   // attributive relative pronoun - [der Mann ,] dessen [Hund]
+	"PRF":     "PRON",  // reflexive personal pronoun - sich, einander
+	"PWS":     "PRON",  // substituting interrogative pronoun - wer, was
+	"PWAT":    "DET",   // attributive interrogative pronoun - welche [Farbe]
+	"PWAV":    "ADV",   // adverbial interrogative or relative pronoun - warum, wo, wann
+	"PAV":     "ADV",   // pronominal adverb - dafür, dabei, deswegen
+	"PROAV":   "ADV",   // pronominal adverb (TIGER) - dafür, dabei, deswegen
+	"PTKZU":   "PART",  // "zu" before infinitive - zu [gehen]
+	"PTKNEG":  "PART",  // negation particle - nicht
+	"PTKVZ":   "ADP",   // separable verbal particle - [er kommt] an
+	"PTKANT":  "PART",  // answer particle - ja, nein, danke
+	"PTKA":    "PART",  // particle with adjective or adverb - am [schönsten], zu [schnell]
+	"TRUNC":   "X",     // truncated word - An- [und Abreise]
+	"VVFIN":   "VERB",  // finite full verb - [du] gehst
+	"VVIMP":   "VERB",  // imperative full verb - komm [!]
+	"VVINF":   "VERB",  // infinitive full verb - gehen, ankommen
+	"VVIZU":   "VERB",  // infinitive with "zu" full verb - anzukommen
+	"VVPP":    "VERB",  // perfect participle full verb - gegangen
+	"VAFIN":   "AUX",   // finite auxiliary verb - [du] bist, [wir] werden
+	"VAIMP":   "AUX",   // imperative auxiliary verb - sei [ruhig !]
+	"VAINF":   "AUX",   // infinitive auxiliary verb - werden, sein
+	"VAPP":    "AUX",   // perfect participle auxiliary verb - gewesen
+	"VMFIN":   "AUX",   // finite modal verb - dürfen
+	"VMINF":   "AUX",   // infinitive modal verb - wollen
+	"VMPP":    "AUX",   // perfect participle modal verb - [er hat] gekonnt
+	"XY":      "X",     // non-word containing non-letter - 3:7, H2O
+	"$,":      "PUNCT", // comma
+	"$.":      "PUNCT", // sentence-final punctuation - . ? ! ; :
+	"$(":      "PUNCT", // other punctuation, sentence-internal - - [ , ] ( )
+}
+
+// Penn Chinese Treebank (as in Stanford CoreNLP Chinese models) - ZH to Universal Dependencies v2 UPOS
+// (Universal Tagset tags are derived), following UD Chinese conversion
+// REF: https://repository.upenn.edu/ircs_reports/37/ (The Part-Of-Speech Tagging Guidelines for the Penn Chinese Treebank)
+// REF: https://github.com/slavpetrov/universal-pos-tags/blob/master/zh-ctb6.map
+// REF: https://universaldependencies.org/zh/
+var ctbZhUPOS = map[string]string{
+	"AD":  "ADV",   // adverb - 还
+	"AS":  "PART",  // aspect marker - 着, 了, 过
+	"BA":  "ADP",   // 把 in ba-construction
+	"CC":  "CCONJ", // coordinating conjunction - 和
+	"CD":  "NUM",   // cardinal number - 一百
+	"CS":  "SCONJ", // subordinating conjunction - 虽然
+	"DEC": "PART",  // 的 in a relative clause
+	"DEG": "PART",  // associative 的
+	"DER": "PART",  // 得 in V-de const. and V-de-R
+	"DEV": "PART",  // 地 before VP
+	"DT":  "DET",   // determiner - 这
+	"ETC": "PART",  // for words 等, 等等
+	"FW":  "X",     // foreign words
+	"IJ":  "INTJ",  // interjection - 啊
+	"JJ":  "ADJ",   // other noun-modifier - 男, 共同
+	"LB":  "ADP",   // 被 in long bei-const
+	"LC":  "ADP",   // localizer - 里
+	"M":   "NOUN",  // measure word - 个
+	"MSP": "PART",  // other particle - 所
+	"NN":  "NOUN",  // common noun - 书
+	"NR":  "PROPN", // proper noun - 美国
+	"NT":  "NOUN",  // temporal noun - 今天
+	"OD":  "NUM",   // ordinal number - 第一
+	"ON":  "X",     // onomatopoeia - 哗啦
+	"P":   "ADP",   // preposition excl. 被 and 把 - 从
+	"PN":  "PRON",  // pronoun - 他
+	"PU":  "PUNCT", // punctuation - ，。
+	"SB":  "ADP",   // 被 in short bei-const
+	"SP":  "PART",  // sentence-final particle - 吗
+	"URL": "X",     // web address
+	"VA":  "ADJ",   // predicative adjective - 红
+	"VC":  "AUX",   // copula 是
+	"VE":  "VERB",  // 有 as the main verb
+	"VV":  "VERB",  // other verb - 走
+	"X":   "X",     // unknown
+}
+
+// brownEnFeatures are the UD features encoded by Brown Corpus tags (looked up by the normalised tags, see normalizeBrown)
+// REF: https://universaldependencies.org/en/feat/
+var brownEnFeatures = map[string]string{
+	"BE":   "VerbForm=Inf",
+	"BED":  "Tense=Past|VerbForm=Fin",
+	"BEDZ": "Number=Sing|Tense=Past|VerbForm=Fin",
+	"BEG":  "VerbForm=Ger",
+	"BEM":  "Number=Sing|Person=1|Tense=Pres|VerbForm=Fin",
+	"BEN":  "Tense=Past|VerbForm=Part",
+	"BER":  "Tense=Pres|VerbForm=Fin",
+	"BEZ":  "Number=Sing|Person=3|Tense=Pres|VerbForm=Fin",
+	"CD":   "NumType=Card",
+	"DOD":  "Tense=Past|VerbForm=Fin",
+	"DOZ":  "Number=Sing|Person=3|Tense=Pres|VerbForm=Fin",
+	"DT":   "Number=Sing",
+	"DTS":  "Number=Plur",
+	"FW":   "Foreign=Yes",
+	"HVD":  "Tense=Past|VerbForm=Fin",
+	"HVG":  "VerbForm=Ger",
+	"HVN":  "Tense=Past|VerbForm=Part",
+	"HVZ":  "Number=Sing|Person=3|Tense=Pres|VerbForm=Fin",
+	"JJ":   "Degree=Pos",
+	"JJR":  "Degree=Cmp",
+	"JJS":  "Degree=Sup",
+	"JJT":  "Degree=Sup",
+	"MD":   "VerbForm=Fin",
+	"NN":   "Number=Sing",
+	"NN$":  "Number=Sing",
+	"NNS":  "Number=Plur",
+	"NNS$": "Number=Plur",
+	"NP":   "Number=Sing",
+	"NP$":  "Number=Sing",
+	"NPS":  "Number=Plur",
+	"NPS$": "Number=Plur",
+	"NR":   "Number=Sing",
+	"NR$":  "Number=Sing",
+	"NRS":  "Number=Plur",
+	"OD":   "NumType=Ord",
+	"PP$":  "Poss=Yes|PronType=Prs",
+	"PP$$": "Poss=Yes|PronType=Prs",
+	"PPL":  "Number=Sing|PronType=Prs|Reflex=Yes",
+	"PPLS": "Number=Plur|PronType=Prs|Reflex=Yes",
+	"PPO":  "Case=Acc|PronType=Prs",
+	"PPS":  "Case=Nom|Number=Sing|Person=3|PronType=Prs",
+	"PPSS": "Case=Nom|PronType=Prs",
+	"RBR":  "Degree=Cmp",
+	"RBT":  "Degree=Sup",
+	"VB":   "VerbForm=Inf",
+	"VBD":  "Tense=Past|VerbForm=Fin",
+	"VBG":  "VerbForm=Ger",
+	"VBN":  "Tense=Past|VerbForm=Part",
+	"VBZ":  "Number=Sing|Person=3|Tense=Pres|VerbForm=Fin",
+	"WDT":  "PronType=Int,Rel",
+	"WP$":  "Poss=Yes|PronType=Int,Rel",
+	"WPO":  "Case=Acc|PronType=Int,Rel",
+	"WPS":  "Case=Nom|PronType=Int,Rel",
+	"WRB":  "PronType=Int,Rel",
+	"*":    "Polarity=Neg",
+}
+
+// claws7EnFeatures are the UD features encoded by CLAWS7 tags (looked up by the normalised tags, see normalizeCLAWS),
+// the base forms of the verbs ("VV0", "VB0", etc.) are finite (present tense, imperative or subjunctive)
+// REF: https://universaldependencies.org/en/feat/
+var claws7EnFeatures = map[string]string{
+	"APPGE": "Poss=Yes|PronType=Prs",
+	"AT1":   "Definite=Ind|PronType=Art",
+	"DA1":   "Number=Sing",
+	"DA2":   "Number=Plur",
+	"DAR":   "Degree=Cmp",
+	"DAT":   "Degree=Sup",
+	"DB2":   "Number=Plur",
+	"DD1":   "Number=Sing",
+	"DD2":   "Number=Plur",
+	"DDQ":   "PronType=Int,Rel",
+	"DDQGE": "Poss=Yes|PronType=Int,Rel",
+	"FW":    "Foreign=Yes",
+	"JJ":    "Degree=Pos",
+	"JJR":   "Degree=Cmp",
+	"JJT":   "Degree=Sup",
+	"MC":    "NumType=Card",
+	"MC1":   "Number=Sing|NumType=Card",
+	"MC2":   "Number=Plur|NumType=Card",
+	"MD":    "NumType=Ord",
+	"MF":    "NumType=Frac",
+	"ND1":   "Number=Sing",
+	"NN1":   "Number=Sing",
+	"NN2":   "Number=Plur",
+	"NNL1":  "Number=Sing",
+	"NNL2":  "Number=Plur",
+	"NNO2":  "Number=Plur",
+	"NNT1":  "Number=Sing",
+	"NNT2":  "Number=Plur",
+	"NNU1":  "Number=Sing",
+	"NNU2":  "Number=Plur",
+	"NP1":   "Number=Sing",
+	"NP2":   "Number=Plur",
+	"NPD1":  "Number=Sing",
+	"NPD2":  "Number=Plur",
+	"NPM1":  "Number=Sing",
+	"NPM2":  "Number=Plur",
+	"PN1":   "Number=Sing",
+	"PNQO":  "Case=Acc|PronType=Int,Rel",
+	"PNQS":  "Case=Nom|PronType=Int,Rel",
+	"PNX1":  "Reflex=Yes",
+	"PPGE":  "Poss=Yes|PronType=Prs",
+	"PPH1":  "Gender=Neut|Number=Sing|Person=3|PronType=Prs",
+	"PPHO1": "Case=Acc|Number=Sing|Person=3|PronType=Prs",
+	"PPHO2": "Case=Acc|Number=Plur|Person=3|PronType=Prs",
+	"PPHS1": "Case=Nom|Number=Sing|Person=3|PronType=Prs",
+	"PPHS2": "Case=Nom|Number=Plur|Person=3|PronType=Prs",
+	"PPIO1": "Case=Acc|Number=Sing|Person=1|PronType=Prs",
+	"PPIO2": "Case=Acc|Number=Plur|Person=1|PronType=Prs",
+	"PPIS1": "Case=Nom|Number=Sing|Person=1|PronType=Prs",
+	"PPIS2": "Case=Nom|Number=Plur|Person=1|PronType=Prs",
+	"PPX1":  "Number=Sing|PronType=Prs|Reflex=Yes",
+	"PPX2":  "Number=Plur|PronType=Prs|Reflex=Yes",
+	"PPY":   "Person=2|PronType=Prs",
+	"RGR":   "Degree=Cmp",
+	"RGT":   "Degree=Sup",
+	"RRQ":   "PronType=Int,Rel",
+	"RRR":   "Degree=Cmp",
+	"RRT":   "Degree=Sup",
+	"VB0":   "VerbForm=Fin",
+	"VBDR":  "Tense=Past|VerbForm=Fin",
+	"VBDZ":  "Number=Sing|Tense=Past|VerbForm=Fin",
+	"VBG":   "VerbForm=Ger",
+	"VBI":   "VerbForm=Inf",
+	"VBM":   "Number=Sing|Person=1|Tense=Pres|VerbForm=Fin",
+	"VBN":   "Tense=Past|VerbForm=Part",
+	"VBR":   "Tense=Pres|VerbForm=Fin",
+	"VBZ":   "Number=Sing|Person=3|Tense=Pres|VerbForm=Fin",
+	"VD0":   "VerbForm=Fin",
+	"VDD":   "Tense=Past|VerbForm=Fin",
+	"VDG":   "VerbForm=Ger",
+	"VDI":   "VerbForm=Inf",
+	"VDN":   "Tense=Past|VerbForm=Part",
+	"VDZ":   "Number=Sing|Person=3|Tense=Pres|VerbForm=Fin",
+	"VH0":   "VerbForm=Fin",
+	"VHD":   "Tense=Past|VerbForm=Fin",
+	"VHG":   "VerbForm=Ger",
+	"VHI":   "VerbForm=Inf",
+	"VHN":   "Tense=Past|VerbForm=Part",
+	"VHZ":   "Number=Sing|Person=3|Tense=Pres|VerbForm=Fin",
+	"VM":    "VerbForm=Fin",
+	"VV0":   "VerbForm=Fin",
+	"VVD":   "Tense=Past|VerbForm=Fin",
+	"VVG":   "VerbForm=Ger",
+	"VVGK":  "VerbForm=Ger",
+	"VVI":   "VerbForm=Inf",
+	"VVN":   "Tense=Past|VerbForm=Part",
+	"VVNK":  "Tense=Past|VerbForm=Part",
+	"VVZ":   "Number=Sing|Person=3|Tense=Pres|VerbForm=Fin",
+	"XX":    "Polarity=Neg",
+	"ZZ1":   "Number=Sing",
+	"ZZ2":   "Number=Plur",
+}
+
+// sttsDeFeatures are the UD features encoded by STTS tags (STTS encodes no inflection, e.g. case or gender)
+// REF: https://universaldependencies.org/tagset-conversion/de-stts-uposf.html
+var sttsDeFeatures = map[string]string{
+	"ART":    "PronType=Art",
+	"CARD":   "NumType=Card",
+	"FM":     "Foreign=Yes",
+	"KOKOM":  "ConjType=Comp",
+	"PDS":    "PronType=Dem",
+	"PDAT":   "PronType=Dem",
+	"PIS":    "PronType=Ind",
+	"PIAT":   "PronType=Ind",
+	"PIDAT":  "PronType=Ind",
+	"PPER":   "PronType=Prs",
+	"PPOSS":  "Poss=Yes|PronType=Prs",
+	"PPOSAT": "Poss=Yes|PronType=Prs",
+	"PRELS":  "PronType=Rel",
+	"PRELAT": "PronType=Rel",
+	"PRF":    "PronType=Prs|Reflex=Yes",
+	"PWS":    "PronType=Int",
+	"PWAT":   "PronType=Int",
+	"PWAV":   "PronType=Int",
+	"PTKNEG": "Polarity=Neg",
+	"PTKZU":  "PartType=Inf",
+	"TRUNC":  "Hyph=Yes",
+	"VVFIN":  "VerbForm=Fin",
+	"VVIMP":  "Mood=Imp|VerbForm=Fin",
+	"VVINF":  "VerbForm=Inf",
+	"VVIZU":  "VerbForm=Inf",
+	"VVPP":   "Aspect=Perf|VerbForm=Part",
+	"VAFIN":  "VerbForm=Fin",
+	"VAIMP":  "Mood=Imp|VerbForm=Fin",
+	"VAINF":  "VerbForm=Inf",
+	"VAPP":   "Aspect=Perf|VerbForm=Part",
+	"VMFIN":  "VerbForm=Fin",
+	"VMINF":  "VerbForm=Inf",
+	"VMPP":   "Aspect=Perf|VerbForm=Part",
+}
+
+// ctbZhFeatures are the UD features encoded by Penn Chinese Treebank tags (Chinese has no inflection)
+// REF: https://universaldependencies.org/zh/
+var ctbZhFeatures = map[string]string{
+	"CD": "NumType=Card",
+	"FW": "Foreign=Yes",
+	"M":  "NounType=Clf",
+	"OD": "NumType=Ord",
+}
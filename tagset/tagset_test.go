@@ -0,0 +1,129 @@
+package tagset_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smileart/lemmingo/tagset"
+)
+
+type MapTestCase struct {
+	word string
+	tag  string
+	pos  string
+	ok   bool
+}
+
+func TestMapTo(t *testing.T) {
+	testCases := map[string][]MapTestCase{
+		tagset.Universal + " penn": {
+			{word: "is", tag: "VBZ", pos: "VERB", ok: true},
+			{word: "can", tag: "MD", pos: "VERB", ok: true},
+			{word: "Alice", tag: "NNP", pos: "NOUN", ok: true},
+			{word: "and", tag: "CC", pos: "CONJ", ok: true},
+		},
+		tagset.UPOS + " penn": {
+			{word: "is", tag: "VBZ", pos: "AUX", ok: true},
+			{word: "Had", tag: "VBD", pos: "AUX", ok: true},
+			{word: "runs", tag: "VBZ", pos: "VERB", ok: true},
+			{word: "can", tag: "MD", pos: "AUX", ok: true},
+			{word: "because", tag: "IN", pos: "SCONJ", ok: true},
+			{word: "in", tag: "IN", pos: "ADP", ok: true},
+			{word: "Alice", tag: "NNP", pos: "PROPN", ok: true},
+			{word: "and", tag: "CC", pos: "CCONJ", ok: true},
+			{word: "to", tag: "TO", pos: "PART", ok: true},
+			{word: "oh", tag: "UH", pos: "INTJ", ok: true},
+			{word: ",", tag: ",", pos: "PUNCT", ok: true},
+			{word: "$", tag: "$", pos: "SYM", ok: true},
+			{word: "is", tag: "XX", pos: "", ok: false},
+		},
+		tagset.UPOS + " freeling": {
+			{word: "wasn't", tag: "VBD+RB", pos: "AUX", ok: true},
+			{word: "didn't", tag: "VBD+RB", pos: "VERB", ok: true},
+			{word: "can't", tag: "MD+RB", pos: "AUX", ok: true},
+		},
+		tagset.UPOS + " wordnet": {
+			{word: "run", tag: "v", pos: "VERB", ok: true},
+		},
+	}
+
+	for name, cases := range testCases {
+		fields := strings.Fields(name)
+		mapPos := tagset.MapTo(fields[0], fields[1], "en-GB")
+
+		for _, tc := range cases {
+			if pos, ok := mapPos(tc.word, tc.tag); pos != tc.pos || ok != tc.ok {
+				t.Errorf("For '%s' %s (%s) we've got: '%s' (%v), expected: '%s' (%v)", tc.word, tc.tag, name, pos, ok, tc.pos, tc.ok)
+			}
+		}
+	}
+}
+
+func TestMapToWrongTarget(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("The method was supposed to panic for unsupported target tagset!")
+		}
+	}()
+
+	tagset.MapTo("ptb", "penn", "en")
+}
+
+func TestMapBuiltinTagsets(t *testing.T) {
+	testCases := map[string][]MapTestCase{
+		tagset.Universal + " brown en": {
+			{word: "House", tag: "NN-TL", pos: "NOUN", ok: true},
+			{word: "it's", tag: "PPS+BEZ", pos: "PRON", ok: true},
+			{word: "didn't", tag: "DOD*", pos: "VERB", ok: true},
+			{word: "de", tag: "FW-IN", pos: "X", ok: true},
+			{word: "quite", tag: "ABL", pos: "ADV", ok: true},
+			{word: "is", tag: "XX", pos: "", ok: false},
+		},
+		tagset.UPOS + " brown en": {
+			{word: "was", tag: "BEDZ", pos: "AUX", ok: true},
+			{word: "Alice", tag: "NP", pos: "PROPN", ok: true},
+			{word: "if", tag: "CS", pos: "SCONJ", ok: true},
+		},
+		tagset.UPOS + " claws7 en": {
+			{word: "gave", tag: "VVD", pos: "VERB", ok: true},
+			{word: "London", tag: "NP1", pos: "PROPN", ok: true},
+			{word: "because", tag: "II21", pos: "ADP", ok: true},
+			{word: "not", tag: "XX", pos: "PART", ok: true},
+			{word: "books", tag: "NN2", pos: "NOUN", ok: true},
+		},
+		tagset.Universal + " claws7 en": {
+			{word: "that", tag: "CST", pos: "CONJ", ok: true},
+		},
+		tagset.UPOS + " stts de-DE": {
+			{word: "bist", tag: "VAFIN", pos: "AUX", ok: true},
+			{word: "gehst", tag: "VVFIN", pos: "VERB", ok: true},
+			{word: "Hamburg", tag: "NE", pos: "PROPN", ok: true},
+			{word: "weil", tag: "KOUS", pos: "SCONJ", ok: true},
+			{word: ",", tag: "$,", pos: "PUNCT", ok: true},
+		},
+		tagset.Universal + " stts de": {
+			{word: "im", tag: "APPRART", pos: "ADP", ok: true},
+			{word: "nicht", tag: "PTKNEG", pos: "PRT", ok: true},
+		},
+		tagset.UPOS + " ctb zh": {
+			{word: "美国", tag: "NR", pos: "PROPN", ok: true},
+			{word: "是", tag: "VC", pos: "AUX", ok: true},
+			{word: "了", tag: "AS", pos: "PART", ok: true},
+		},
+		tagset.Universal + " ctb zh-Hans": {
+			{word: "书", tag: "NN", pos: "NOUN", ok: true},
+			{word: "，", tag: "PU", pos: ".", ok: true},
+		},
+	}
+
+	for name, cases := range testCases {
+		fields := strings.Fields(name)
+		mapPos := tagset.MapTo(fields[0], fields[1], fields[2])
+
+		for _, tc := range cases {
+			if pos, ok := mapPos(tc.word, tc.tag); pos != tc.pos || ok != tc.ok {
+				t.Errorf("For '%s' %s (%s) we've got: '%s' (%v), expected: '%s' (%v)", tc.word, tc.tag, name, pos, ok, tc.pos, tc.ok)
+			}
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package tagset
+
+import (
+	"errors"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// UniMorph features schema, feature bundles are ";" joined features, e.g. "V;PST" or "N;PL"
+// REF: https://unimorph.github.io/schema/
+// REF: https://unimorph.github.io/doc/unimorph-schema.pdf
+
+// unimorphRule maps UniMorph PoS with all the required features to a tag
+type unimorphRule struct {
+	pos      string
+	features []string
+	tag      string
+}
+
+// unimorphUniversal maps UniMorph PoS features to Universal Tagset PoS
+var unimorphUniversal = map[string]string{
+	"N":      "NOUN",
+	"PROPN":  "NOUN",
+	"ADJ":    "ADJ",
+	"PRO":    "PRON",
+	"CLF":    "X",
+	"ART":    "DET",
+	"DET":    "DET",
+	"V":      "VERB",
+	"V.PTCP": "VERB",
+	"V.MSDR": "VERB",
+	"V.CVB":  "VERB",
+	"AUX":    "VERB",
+	"ADV":    "ADV",
+	"ADP":    "ADP",
+	"COMP":   "ADP",
+	"CONJ":   "CONJ",
+	"NUM":    "NUM",
+	"PART":   "PRT",
+	"INTJ":   "X",
+}
+
+// unimorphPennEn maps UniMorph feature bundles to Penn Treebank tags, the first matching rule wins
+var unimorphPennEn = []unimorphRule{
+	{pos: "V", features: []string{"V.PTCP", "PST"}, tag: "VBN"},
+	{pos: "V", features: []string{"V.PTCP", "PRS"}, tag: "VBG"},
+	{pos: "V", features: []string{"V.PTCP"}, tag: "VBN"},
+	{pos: "V", features: []string{"V.MSDR"}, tag: "VBG"},
+	{pos: "V", features: []string{"PST"}, tag: "VBD"},
+	{pos: "V", features: []string{"NFIN"}, tag: "VB"},
+	{pos: "V", features: []string{"3", "SG", "PRS"}, tag: "VBZ"},
+	{pos: "V", features: []string{"PRS"}, tag: "VBP"},
+	{pos: "V", tag: "VB"},
+	{pos: "V.PTCP", features: []string{"PRS"}, tag: "VBG"},
+	{pos: "V.PTCP", tag: "VBN"},
+	{pos: "V.MSDR", tag: "VBG"},
+	{pos: "AUX", tag: "MD"},
+	{pos: "N", features: []string{"PL"}, tag: "NNS"},
+	{pos: "N", tag: "NN"},
+	{pos: "PROPN", features: []string{"PL"}, tag: "NNPS"},
+	{pos: "PROPN", tag: "NNP"},
+	{pos: "ADJ", features: []string{"CMPR"}, tag: "JJR"},
+	{pos: "ADJ", features: []string{"SPRL"}, tag: "JJS"},
+	{pos: "ADJ", tag: "JJ"},
+	{pos: "ADV", features: []string{"CMPR"}, tag: "RBR"},
+	{pos: "ADV", features: []string{"SPRL"}, tag: "RBS"},
+	{pos: "ADV", tag: "RB"},
+	{pos: "PRO", features: []string{"PSS"}, tag: "PRP$"},
+	{pos: "PRO", tag: "PRP"},
+	{pos: "ART", tag: "DT"},
+	{pos: "DET", tag: "DT"},
+	{pos: "ADP", tag: "IN"},
+	{pos: "COMP", tag: "IN"},
+	{pos: "CONJ", tag: "CC"},
+	{pos: "NUM", tag: "CD"},
+	{pos: "PART", tag: "RP"},
+	{pos: "INTJ", tag: "UH"},
+}
+
+// unimorphFeatures lists the features of UniMorph schema (except PoS ones), language specific and unknown features get reported
+var unimorphFeatures = toSet(strings.Fields(`
+	0 1 2 3 4 INCL EXCL PRX OBV
+	SG PL DU TRI PAUC GRPL GPAUC
+	PRS PST FUT IMMED HOD 1DAY RCT RMT
+	IPFV PFV PRF PROG PROSP ITER HAB
+	IND SBJV REAL IRR AUPRP AUNPRP IMP COND PURP INTEN POT LKLY ADM OBLIG DEB PERM DED SIM OPT
+	FIN NFIN
+	ACT MID PASS ANTIP DIR INV AGFOC PFOC LFOC BFOC ACFOC IFOC CFOC APPL CAUS RECP REFL
+	NOM ACC ERG ABS NOMS DAT BEN PRP GEN REL PRT INS COM VOC COMPV EQTV PRIV PROPR AVR FRML TRANS BYWAY
+	INTER AT POST IN CIRC ANTE APUD ON ONHR ONVR SUB REM PROXM ESS ALL ABL APPRX TERM
+	MASC FEM NEUT
+	DEF INDF SPEC NSPEC
+	CMPR SPRL AB RL EQT
+	POS NEG
+	FH DRCT SEN VISU NVSEN AUD NFH QUOT RPRT HRSY INFER ASSUM
+	POL ELEV FOREG COL HUMB AVOID LOW STELEV STSUPR
+	PSS ALN NALN
+	ANIM INAN HUM NHUM
+	IMPRS INTR TR DITR
+	DECL INT
+	V.PTCP V.MSDR V.CVB
+`))
+
+// MapUniMorph maps UniMorph feature bundle (e.g. "V;PST") to the tag of target tagset for languageTag:
+// "universal" (Universal Tagset, any language) or "penn"/"freeling" (English).
+//
+// It returns the tag, features of the bundle unknown to UniMorph schema (e.g. language specific "LGSPEC1"),
+// and false if the bundle has no mapping in the target tagset (or an error if the target isn't supported).
+func MapUniMorph(bundle string, target string, languageTag string) (string, []string, bool, error) {
+	features := strings.Split(bundle, ";")
+	set := toSet(features)
+
+	var (
+		pos     string
+		unknown []string
+	)
+
+	for _, feature := range features {
+		switch {
+		case pos == "" && unimorphUniversal[feature] != "":
+			pos = feature
+		case !knownUniMorphFeature(feature):
+			unknown = append(unknown, feature)
+		}
+	}
+
+	base, _ := language.Make(languageTag).Base()
+	languageTag = base.String()
+
+	if target == Universal {
+		tag, ok := unimorphUniversal[pos]
+
+		return tag, unknown, ok, nil
+	}
+
+	var rules []unimorphRule
+
+	switch target + "_" + languageTag {
+	case "penn_en", "freeling_en":
+		rules = unimorphPennEn
+	default:
+		return "", nil, false, errors.New("UniMorph mapping to `" + target + "_" + languageTag + "` was not found!")
+	}
+
+	for _, rule := range rules {
+		if rule.pos == pos && containsAll(set, rule.features) {
+			return rule.tag, unknown, true, nil
+		}
+	}
+
+	return "", unknown, false, nil
+}
+
+// knownUniMorphFeature checks if the feature is a part of UniMorph schema (including agreement/possession features like "ARGNO3S")
+func knownUniMorphFeature(feature string) bool {
+	if unimorphFeatures[feature] || unimorphUniversal[feature] != "" {
+		return true
+	}
+
+	// agreement (ARGNO3S) and possession (PSS1S) features are compositions of the known ones
+	return strings.HasPrefix(feature, "ARG") || strings.HasPrefix(feature, "PSS")
+}
+
+// toSet turns a slice of strings into a set
+func toSet(items []string) map[string]bool {
+	set := make(map[string]bool, len(items))
+
+	for _, item := range items {
+		set[item] = true
+	}
+
+	return set
+}
+
+// containsAll checks if the set contains all the items
+func containsAll(set map[string]bool, items []string) bool {
+	for _, item := range items {
+		if !set[item] {
+			return false
+		}
+	}
+
+	return true
+}